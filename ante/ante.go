@@ -43,6 +43,8 @@ type HandlerOptions struct {
 	IBCkeeper             *ibckeeper.Keeper
 	StakingKeeper         *stakingkeeper.Keeper
 	FeeMarketKeeper       *feemarketkeeper.Keeper
+	MsgPolicyChecker      *MsgPolicyChecker
+	MetaprotocolsKeeper   MetaprotocolsKeeper
 	TxFeeChecker          ante.TxFeeChecker
 	TXCounterStoreService corestoretypes.KVStoreService
 	WasmConfig            *wasmtypes.NodeConfig
@@ -64,6 +66,12 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 	if opts.FeeMarketKeeper == nil {
 		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "FeeMarket keeper is required for AnteHandler")
 	}
+	if opts.MsgPolicyChecker == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "message policy checker is required for AnteHandler")
+	}
+	if opts.MetaprotocolsKeeper == nil {
+		return nil, errorsmod.Wrap(gaiaerrors.ErrLogic, "metaprotocols keeper is required for AnteHandler")
+	}
 
 	if opts.StakingKeeper == nil {
 		return nil, errorsmod.Wrap(gaiaerrors.ErrNotFound, "staking param store is required for AnteHandler")
@@ -78,12 +86,14 @@ func NewAnteHandler(opts HandlerOptions) (sdk.AnteHandler, error) {
 		ante.NewSetUpContextDecorator(),                                               // outermost AnteDecorator. SetUpContext must be called first
 		wasmkeeper.NewLimitSimulationGasDecorator(opts.WasmConfig.SimulationGasLimit), // after setup context to enforce limits early
 		wasmkeeper.NewCountTXDecorator(opts.TXCounterStoreService),
-		ante.NewExtensionOptionsDecorator(opts.ExtensionOptionChecker),
+		NewExtensionOptionsDecorator(opts.ExtensionOptionChecker, opts.MetaprotocolsKeeper),
 		ante.NewValidateBasicDecorator(),
 		ante.NewTxTimeoutHeightDecorator(),
 		ante.NewValidateMemoDecorator(opts.AccountKeeper),
 		ante.NewConsumeGasForTxSizeDecorator(opts.AccountKeeper),
 		NewGovVoteDecorator(opts.Codec, opts.StakingKeeper),
+		NewMsgPolicyDecorator(opts.MsgPolicyChecker),
+		NewMetaprotocolsDecorator(opts.MetaprotocolsKeeper),
 		ante.NewSetPubKeyDecorator(opts.AccountKeeper), // SetPubKeyDecorator must be called before all signature verification decorators
 		ante.NewValidateSigCountDecorator(opts.AccountKeeper),
 		ante.NewSigGasConsumeDecorator(opts.AccountKeeper, sigGasConsumer),
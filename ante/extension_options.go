@@ -0,0 +1,49 @@
+package ante
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+)
+
+// ExtensionOptionsDecorator rejects critical extension options unless they
+// are accepted by the checker or allowed by the x/metaprotocols params.
+// It replaces the SDK decorator, whose checker cannot read the state.
+type ExtensionOptionsDecorator struct {
+	checker authante.ExtensionOptionChecker
+	keeper  MetaprotocolsKeeper
+}
+
+func NewExtensionOptionsDecorator(checker authante.ExtensionOptionChecker, keeper MetaprotocolsKeeper) ExtensionOptionsDecorator {
+	return ExtensionOptionsDecorator{
+		checker: checker,
+		keeper:  keeper,
+	}
+}
+
+func (d ExtensionOptionsDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, option := range extTx.GetExtensionOptions() {
+		if d.checker != nil && d.checker(option) {
+			continue
+		}
+		allowed, err := d.keeper.IsExtensionOptionAllowed(ctx, option)
+		if err != nil {
+			return ctx, err
+		}
+		if !allowed {
+			return ctx, errorsmod.Wrapf(sdkerrors.ErrUnknownExtensionOptions, "%s is not allowed", option.TypeUrl)
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
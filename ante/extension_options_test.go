@@ -0,0 +1,102 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+
+	"github.com/cosmos/gaia/v29/ante"
+	"github.com/cosmos/gaia/v29/app/helpers"
+	metaprotocolstypes "github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+func TestExtensionOptionsDecorator(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	keeper := gaiaApp.MetaprotocolsKeeper
+
+	extTypeURL := sdk.MsgTypeURL(&metaprotocolstypes.ExtensionData{})
+	require.NoError(t, keeper.SetProtocol(ctx, metaprotocolstypes.RegisteredProtocol{ProtocolId: "posts", MaxDataSize: 100}))
+
+	extension := func(protocolID string) *codectypes.Any {
+		option, err := codectypes.NewAnyWithValue(&metaprotocolstypes.ExtensionData{ProtocolId: protocolID, ProtocolVersion: "1"})
+		require.NoError(t, err)
+		return option
+	}
+	// registered as an extension option to decode historic txs
+	otherOption, err := codectypes.NewAnyWithValue(&authz.MsgRevoke{Granter: "granter", Grantee: "grantee", MsgTypeUrl: "/cosmos.bank.v1beta1.MsgSend"})
+	require.NoError(t, err)
+
+	tests := []struct {
+		name    string
+		allowed []string
+		checker func(*codectypes.Any) bool
+		option  *codectypes.Any
+		expErr  error
+	}{
+		{
+			name:   "nothing allowed by default",
+			option: extension("posts"),
+			expErr: sdkerrors.ErrUnknownExtensionOptions,
+		},
+		{
+			name:    "allowed extension data of a registered protocol",
+			allowed: []string{extTypeURL},
+			option:  extension("posts"),
+		},
+		{
+			name:    "allowed extension data of an unregistered protocol",
+			allowed: []string{extTypeURL},
+			option:  extension("other"),
+			expErr:  sdkerrors.ErrUnknownExtensionOptions,
+		},
+		{
+			name:    "allowed type URL",
+			allowed: []string{extTypeURL, otherOption.TypeUrl},
+			option:  otherOption,
+		},
+		{
+			name:    "type URL not allowed",
+			allowed: []string{extTypeURL},
+			option:  otherOption,
+			expErr:  sdkerrors.ErrUnknownExtensionOptions,
+		},
+		{
+			name:    "accepted by the checker",
+			checker: func(option *codectypes.Any) bool { return option.TypeUrl == otherOption.TypeUrl },
+			option:  otherOption,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.NoError(t, keeper.SetParams(ctx, metaprotocolstypes.NewParams(false, tc.allowed)))
+
+			builder, ok := gaiaApp.GetTxConfig().NewTxBuilder().(authtx.ExtensionOptionsTxBuilder)
+			require.True(t, ok)
+			builder.SetExtensionOptions(tc.option)
+			txBytes, err := gaiaApp.GetTxConfig().TxEncoder()(builder.GetTx())
+			require.NoError(t, err)
+			tx, err := gaiaApp.GetTxConfig().TxDecoder()(txBytes)
+			require.NoError(t, err)
+
+			decorator := ante.NewExtensionOptionsDecorator(tc.checker, keeper)
+			_, err = decorator.AnteHandle(ctx, tx, false, func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
@@ -1,30 +1,24 @@
 package ante
 
 import (
-	errorsmod "cosmossdk.io/errors"
-
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	"github.com/cosmos/cosmos-sdk/x/authz"
 	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
 	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
 
-	gaiaerrors "github.com/cosmos/gaia/v29/types/errors"
 	gaiagov "github.com/cosmos/gaia/v29/x/gov"
 )
 
-var maxWrappedMessageDepth = 20 // maximum depth of nested exec messages allowed
-
 type GovVoteDecorator struct {
 	stakingKeeper *stakingkeeper.Keeper
-	cdc           codec.BinaryCodec
+	walker        NestedMsgWalker
 }
 
 func NewGovVoteDecorator(cdc codec.BinaryCodec, stakingKeeper *stakingkeeper.Keeper) GovVoteDecorator {
 	return GovVoteDecorator{
 		stakingKeeper: stakingKeeper,
-		cdc:           cdc,
+		walker:        NewNestedMsgWalker(cdc),
 	}
 }
 
@@ -45,33 +39,16 @@ func (g GovVoteDecorator) AnteHandle(
 	return next(ctx, tx, simulate)
 }
 
-// ValidateVoteMsgs checks if a voter has enough stake to vote
+// ValidateVoteMsgs checks if a voter has enough stake to vote, including
+// votes nested inside other messages. Votes sent to an ICA host are skipped
+// since they are subject to the host chain's rules.
 func (g GovVoteDecorator) ValidateVoteMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
-	for _, msg := range msgs {
-		if err := g.validateMsgRecursive(ctx, msg, 0); err != nil {
-			return err
-		}
-	}
-	return nil
-}
-
-func (g GovVoteDecorator) validateMsgRecursive(ctx sdk.Context, m sdk.Msg, iters int) error {
-	if iters >= maxWrappedMessageDepth {
-		return errorsmod.Wrap(gaiaerrors.ErrNestedMessageLimitExceeded, "too many wrapped sdk messages")
-	}
-	if msg, ok := m.(*authz.MsgExec); ok {
-		for _, v := range msg.Msgs {
-			var innerMsg sdk.Msg
-			if err := g.cdc.UnpackAny(v, &innerMsg); err != nil {
-				return errorsmod.Wrap(gaiaerrors.ErrUnauthorized, "cannot unmarshal authz exec msgs")
-			}
-			if err := g.validateMsgRecursive(ctx, innerMsg, iters+1); err != nil {
-				return err
-			}
+	return g.walker.Walk(msgs, func(nested NestedMsg) error {
+		if nested.Remote {
+			return nil
 		}
-		return nil
-	}
-	return g.validMsg(ctx, m)
+		return g.validMsg(ctx, nested.Msg)
+	})
 }
 
 func (g GovVoteDecorator) validMsg(ctx sdk.Context, m sdk.Msg) error {
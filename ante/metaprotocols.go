@@ -0,0 +1,55 @@
+package ante
+
+import (
+	"context"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	metaprotocolstypes "github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+// MetaprotocolsKeeper validates ExtensionData against the metaprotocols
+// registry and decides which critical extension options are allowed.
+type MetaprotocolsKeeper interface {
+	ValidateExtension(ctx context.Context, ext *metaprotocolstypes.ExtensionData) error
+	IsExtensionOptionAllowed(ctx context.Context, option *codectypes.Any) (bool, error)
+}
+
+// MetaprotocolsDecorator rejects transactions carrying ExtensionData of an
+// unregistered protocol, or data violating the constraints of its protocol,
+// while the x/metaprotocols registry is enforced.
+type MetaprotocolsDecorator struct {
+	keeper MetaprotocolsKeeper
+}
+
+func NewMetaprotocolsDecorator(keeper MetaprotocolsKeeper) MetaprotocolsDecorator {
+	return MetaprotocolsDecorator{
+		keeper: keeper,
+	}
+}
+
+func (d MetaprotocolsDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+
+	for _, options := range [][]*codectypes.Any{extTx.GetExtensionOptions(), extTx.GetNonCriticalExtensionOptions()} {
+		for _, option := range options {
+			ext, ok := option.GetCachedValue().(*metaprotocolstypes.ExtensionData)
+			if !ok {
+				continue
+			}
+			if err := d.keeper.ValidateExtension(ctx, ext); err != nil {
+				return ctx, err
+			}
+		}
+	}
+
+	return next(ctx, tx, simulate)
+}
@@ -0,0 +1,265 @@
+package ante
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+
+	gaiaerrors "github.com/cosmos/gaia/v29/types/errors"
+	gaiagov "github.com/cosmos/gaia/v29/x/gov"
+	msgpolicytypes "github.com/cosmos/gaia/v29/x/msgpolicy/types"
+)
+
+// MsgPolicyKeeper provides the message policies enabled by governance.
+type MsgPolicyKeeper interface {
+	GetEnabledPolicies(ctx context.Context) ([]msgpolicytypes.MsgPolicy, error)
+}
+
+// MsgPolicyChecker enforces the governance controlled message policies of
+// the x/msgpolicy module. The same checker is used by the MsgPolicyDecorator
+// for transactions, and by the MsgPolicyRouter for messages dispatched by
+// authz, wasm contracts and ICA host accounts.
+//
+// The stake required to vote (GovVoteDecorator, GovVoteMessageHandler) and the
+// x/bank MultiSend limits are always enforced and are not message policies.
+type MsgPolicyChecker struct {
+	cdc           codec.Codec
+	walker        NestedMsgWalker
+	policyKeeper  MsgPolicyKeeper
+	stakingKeeper *stakingkeeper.Keeper
+}
+
+func NewMsgPolicyChecker(cdc codec.Codec, policyKeeper MsgPolicyKeeper, stakingKeeper *stakingkeeper.Keeper) *MsgPolicyChecker {
+	return &MsgPolicyChecker{
+		cdc:           cdc,
+		walker:        NewNestedMsgWalker(cdc),
+		policyKeeper:  policyKeeper,
+		stakingKeeper: stakingKeeper,
+	}
+}
+
+// msgPolicyCountsKey is the context key of the msgPolicyCounts of the
+// transaction being executed.
+type msgPolicyCountsKey struct{}
+
+// msgPolicyCounts holds the number of messages matching each policy of an
+// entry point: a transaction, whose counts are set in the context by the
+// MsgPolicyDecorator, or a packet, whose counts are set by the
+// MsgPolicyIBCMiddleware. They are shared with the MsgPolicyRouter, so that
+// MaxPerTx applies to all the messages of the entry point, including those
+// routed by authz and wasm contracts while it is executed, while the packets
+// a relayer batches in one transaction, such as those executed by the ICA
+// host, do not share their counts.
+type msgPolicyCounts struct {
+	counts map[string]uint32
+	// counted holds the messages already counted, so that the messages nested
+	// in the transaction are not counted a second time when they are routed.
+	counted map[sdk.Msg]struct{}
+}
+
+// WithMsgPolicyCounts returns a context in which the messages checked by the
+// MsgPolicyChecker are counted from zero. The counts are shared by all the
+// contexts derived from it.
+func WithMsgPolicyCounts(ctx sdk.Context) sdk.Context {
+	return ctx.WithValue(msgPolicyCountsKey{}, &msgPolicyCounts{
+		counts:  make(map[string]uint32),
+		counted: make(map[sdk.Msg]struct{}),
+	})
+}
+
+// clone returns a copy of the counts.
+func (c *msgPolicyCounts) clone() msgPolicyCounts {
+	clone := msgPolicyCounts{
+		counts:  make(map[string]uint32, len(c.counts)),
+		counted: make(map[sdk.Msg]struct{}, len(c.counted)),
+	}
+	for name, count := range c.counts {
+		clone.counts[name] = count
+	}
+	for msg := range c.counted {
+		clone.counted[msg] = struct{}{}
+	}
+	return clone
+}
+
+// msgPolicyCountsFromContext returns the counts set by WithMsgPolicyCounts, or
+// new counts if there are none, e.g. for messages routed outside of a
+// transaction.
+func msgPolicyCountsFromContext(ctx sdk.Context) *msgPolicyCounts {
+	if counts, ok := ctx.Value(msgPolicyCountsKey{}).(*msgPolicyCounts); ok {
+		return counts
+	}
+	return &msgPolicyCounts{
+		counts:  make(map[string]uint32),
+		counted: make(map[sdk.Msg]struct{}),
+	}
+}
+
+// CheckMsgs applies the enabled policies to msgs and every message nested
+// inside them. Messages executed on an ICA host chain are not checked.
+// Matching messages are added to the counts of the transaction set in ctx by
+// WithMsgPolicyCounts; a message already counted, e.g. one nested in an authz
+// MsgExec that is then routed, is only checked again for its signers.
+func (c *MsgPolicyChecker) CheckMsgs(ctx sdk.Context, msgs []sdk.Msg) error {
+	policies, err := c.policyKeeper.GetEnabledPolicies(ctx)
+	if err != nil {
+		return err
+	}
+	if len(policies) == 0 {
+		return nil
+	}
+
+	registry := make(map[string][]*msgpolicytypes.MsgPolicy)
+	for i := range policies {
+		for _, typeURL := range policies[i].TypeUrls {
+			registry[typeURL] = append(registry[typeURL], &policies[i])
+		}
+	}
+
+	txCounts := msgPolicyCountsFromContext(ctx)
+	return c.walker.Walk(msgs, func(nested NestedMsg) error {
+		if nested.Remote {
+			return nil
+		}
+		_, counted := txCounts.counted[nested.Msg]
+		txCounts.counted[nested.Msg] = struct{}{}
+		for _, policy := range registry[sdk.MsgTypeURL(nested.Msg)] {
+			if !counted {
+				txCounts.counts[policy.Name]++
+			}
+			if policy.MaxPerTx > 0 && txCounts.counts[policy.Name] > policy.MaxPerTx {
+				return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+					"message policy %s allows at most %d matching messages per tx", policy.Name, policy.MaxPerTx)
+			}
+			if err := c.checkSigners(ctx, policy, nested.Msg); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+func (c *MsgPolicyChecker) checkSigners(ctx sdk.Context, policy *msgpolicytypes.MsgPolicy, msg sdk.Msg) error {
+	checkStake := policy.MinStake.IsPositive()
+	if !checkStake && len(policy.AllowedSigners) == 0 {
+		return nil
+	}
+
+	signers, _, err := c.cdc.GetMsgV1Signers(msg)
+	if err != nil {
+		return err
+	}
+	for _, signer := range signers {
+		addr := sdk.AccAddress(signer)
+		if len(policy.AllowedSigners) > 0 && !isAllowedSigner(policy.AllowedSigners, addr) {
+			return errorsmod.Wrapf(gaiaerrors.ErrUnauthorized,
+				"message policy %s does not allow signer %s", policy.Name, addr)
+		}
+		if checkStake {
+			enoughStake, err := gaiagov.HasMinStake(ctx, c.stakingKeeper, addr, math.LegacyNewDecFromInt(policy.MinStake))
+			if err != nil {
+				return err
+			}
+			if !enoughStake {
+				return errorsmod.Wrapf(gaiaerrors.ErrInsufficientStake,
+					"message policy %s requires signer %s to stake at least %s", policy.Name, addr, policy.MinStake)
+			}
+		}
+	}
+	return nil
+}
+
+func isAllowedSigner(allowed []string, addr sdk.AccAddress) bool {
+	for _, a := range allowed {
+		allowedAddr, err := sdk.AccAddressFromBech32(a)
+		if err == nil && allowedAddr.Equals(addr) {
+			return true
+		}
+	}
+	return false
+}
+
+// MsgPolicyDecorator applies the message policies to transaction messages.
+// It resets the message counts of the transaction, which the MsgPolicyRouter
+// then updates with the messages routed during its execution.
+type MsgPolicyDecorator struct {
+	checker *MsgPolicyChecker
+}
+
+func NewMsgPolicyDecorator(checker *MsgPolicyChecker) MsgPolicyDecorator {
+	return MsgPolicyDecorator{
+		checker: checker,
+	}
+}
+
+func (d MsgPolicyDecorator) AnteHandle(
+	ctx sdk.Context, tx sdk.Tx,
+	simulate bool, next sdk.AnteHandler,
+) (newCtx sdk.Context, err error) {
+	ctx = WithMsgPolicyCounts(ctx)
+	if err = d.checker.CheckMsgs(ctx, tx.GetMsgs()); err != nil {
+		return ctx, err
+	}
+
+	return next(ctx, tx, simulate)
+}
+
+var _ baseapp.MessageRouter = MsgPolicyRouter{}
+
+// MsgPolicyRouter wraps a message router so that the message policies are
+// applied to messages routed outside of a transaction's top level, i.e. the
+// messages executed by authz MsgExec, dispatched by wasm contracts or
+// executed by ICA host accounts. The counts of a routed message that fails
+// are reverted with its state changes, so that it does not count towards the
+// MaxPerTx of the messages routed after it, e.g. when a wasm contract handles
+// the failure of a submessage.
+type MsgPolicyRouter struct {
+	baseapp.MessageRouter
+	checker *MsgPolicyChecker
+}
+
+func NewMsgPolicyRouter(router baseapp.MessageRouter, checker *MsgPolicyChecker) MsgPolicyRouter {
+	return MsgPolicyRouter{
+		MessageRouter: router,
+		checker:       checker,
+	}
+}
+
+// Handler returns the handler for msg, preceded by the policy checks.
+func (r MsgPolicyRouter) Handler(msg sdk.Msg) baseapp.MsgServiceHandler {
+	return r.wrap(r.MessageRouter.Handler(msg))
+}
+
+// HandlerByTypeURL returns the handler for typeURL, preceded by the policy checks.
+func (r MsgPolicyRouter) HandlerByTypeURL(typeURL string) baseapp.MsgServiceHandler {
+	return r.wrap(r.MessageRouter.HandlerByTypeURL(typeURL))
+}
+
+func (r MsgPolicyRouter) wrap(handler baseapp.MsgServiceHandler) baseapp.MsgServiceHandler {
+	if handler == nil {
+		return nil
+	}
+	return func(ctx sdk.Context, msg sdk.Msg) (*sdk.Result, error) {
+		counts := msgPolicyCountsFromContext(ctx)
+		saved := counts.clone()
+		res, err := r.handle(ctx, handler, msg)
+		if err != nil {
+			*counts = saved
+		}
+		return res, err
+	}
+}
+
+func (r MsgPolicyRouter) handle(ctx sdk.Context, handler baseapp.MsgServiceHandler, msg sdk.Msg) (*sdk.Result, error) {
+	if err := r.checker.CheckMsgs(ctx, []sdk.Msg{msg}); err != nil {
+		return nil, err
+	}
+	return handler(ctx, msg)
+}
@@ -0,0 +1,96 @@
+package ante
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+var _ porttypes.IBCModule = MsgPolicyIBCMiddleware{}
+
+// MsgPolicyIBCMiddleware counts the messages routed while a packet callback is
+// executed, e.g. by the ICA host or a wasm contract, from zero, so that the
+// MaxPerTx of the message policies applies per packet rather than to all the
+// packets a relayer batches in a transaction.
+type MsgPolicyIBCMiddleware struct {
+	porttypes.IBCModule
+}
+
+// NewMsgPolicyIBCMiddleware returns a new MsgPolicyIBCMiddleware wrapping the
+// IBC stack app.
+func NewMsgPolicyIBCMiddleware(app porttypes.IBCModule) MsgPolicyIBCMiddleware {
+	return MsgPolicyIBCMiddleware{
+		IBCModule: app,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im MsgPolicyIBCMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	return im.IBCModule.OnRecvPacket(WithMsgPolicyCounts(ctx), channelVersion, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im MsgPolicyIBCMiddleware) OnAcknowledgementPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	return im.IBCModule.OnAcknowledgementPacket(WithMsgPolicyCounts(ctx), channelVersion, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im MsgPolicyIBCMiddleware) OnTimeoutPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	return im.IBCModule.OnTimeoutPacket(WithMsgPolicyCounts(ctx), channelVersion, packet, relayer)
+}
+
+var _ api.IBCModule = MsgPolicyIBCMiddlewareV2{}
+
+// MsgPolicyIBCMiddlewareV2 is the MsgPolicyIBCMiddleware of the IBC v2 stacks.
+type MsgPolicyIBCMiddlewareV2 struct {
+	api.IBCModule
+}
+
+// NewMsgPolicyIBCMiddlewareV2 returns a new MsgPolicyIBCMiddlewareV2 wrapping
+// the IBC v2 stack app.
+func NewMsgPolicyIBCMiddlewareV2(app api.IBCModule) MsgPolicyIBCMiddlewareV2 {
+	return MsgPolicyIBCMiddlewareV2{
+		IBCModule: app,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im MsgPolicyIBCMiddlewareV2) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	return im.IBCModule.OnRecvPacket(WithMsgPolicyCounts(ctx), sourceClient, destinationClient, sequence, payload, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im MsgPolicyIBCMiddlewareV2) OnAcknowledgementPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	acknowledgement []byte,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	return im.IBCModule.OnAcknowledgementPacket(WithMsgPolicyCounts(ctx), sourceClient, destinationClient, sequence, acknowledgement, payload, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im MsgPolicyIBCMiddlewareV2) OnTimeoutPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) error {
+	return im.IBCModule.OnTimeoutPacket(WithMsgPolicyCounts(ctx), sourceClient, destinationClient, sequence, payload, relayer)
+}
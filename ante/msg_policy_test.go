@@ -0,0 +1,255 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/cosmos/gaia/v29/ante"
+	"github.com/cosmos/gaia/v29/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v29/types/errors"
+	msgpolicytypes "github.com/cosmos/gaia/v29/x/msgpolicy/types"
+)
+
+func TestMsgPolicyChecker(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	checker := gaiaApp.MsgPolicyChecker
+
+	// this account was created during setup and has a delegation
+	acc, err := gaiaApp.AccountKeeper.Accounts.Indexes.Number.MatchExact(ctx, 0)
+	require.NoError(t, err)
+	staker := sdk.AccAddress(acc)
+	other := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+
+	sendTypeURL := sdk.MsgTypeURL(&banktypes.MsgSend{})
+	send := func(from sdk.AccAddress) sdk.Msg {
+		return banktypes.NewMsgSend(from, other, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+	}
+
+	tests := []struct {
+		name   string
+		policy msgpolicytypes.MsgPolicy
+		msgs   []sdk.Msg
+		expErr error
+	}{
+		{
+			name: "max per tx not exceeded",
+			policy: msgpolicytypes.MsgPolicy{
+				Name: "max-sends", TypeUrls: []string{sendTypeURL}, Enabled: true,
+				MinStake: math.ZeroInt(), MaxPerTx: 2,
+			},
+			msgs: []sdk.Msg{send(other), send(other)},
+		},
+		{
+			name: "max per tx exceeded by nested messages",
+			policy: msgpolicytypes.MsgPolicy{
+				Name: "max-sends", TypeUrls: []string{sendTypeURL}, Enabled: true,
+				MinStake: math.ZeroInt(), MaxPerTx: 2,
+			},
+			msgs:   []sdk.Msg{send(other), wrapInExec(t, send(other), send(other))},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "disabled policy is not enforced",
+			policy: msgpolicytypes.MsgPolicy{
+				Name: "max-sends", TypeUrls: []string{sendTypeURL}, Enabled: false,
+				MinStake: math.ZeroInt(), MaxPerTx: 1,
+			},
+			msgs: []sdk.Msg{send(other), send(other)},
+		},
+		{
+			name: "signer allowed",
+			policy: msgpolicytypes.MsgPolicy{
+				Name: "allowed-senders", TypeUrls: []string{sendTypeURL}, Enabled: true,
+				MinStake: math.ZeroInt(), AllowedSigners: []string{staker.String()},
+			},
+			msgs: []sdk.Msg{send(staker)},
+		},
+		{
+			name: "signer not allowed",
+			policy: msgpolicytypes.MsgPolicy{
+				Name: "allowed-senders", TypeUrls: []string{sendTypeURL}, Enabled: true,
+				MinStake: math.ZeroInt(), AllowedSigners: []string{staker.String()},
+			},
+			msgs:   []sdk.Msg{wrapInExec(t, send(other))},
+			expErr: gaiaerrors.ErrUnauthorized,
+		},
+		{
+			name: "signer has min stake",
+			policy: msgpolicytypes.MsgPolicy{
+				Name: "staked-senders", TypeUrls: []string{sendTypeURL}, Enabled: true,
+				MinStake: math.OneInt(),
+			},
+			msgs: []sdk.Msg{send(staker)},
+		},
+		{
+			name: "signer without min stake",
+			policy: msgpolicytypes.MsgPolicy{
+				Name: "staked-senders", TypeUrls: []string{sendTypeURL}, Enabled: true,
+				MinStake: math.OneInt(),
+			},
+			msgs:   []sdk.Msg{send(other)},
+			expErr: gaiaerrors.ErrInsufficientStake,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := gaiaApp.MsgPolicyKeeper.SetParams(ctx, msgpolicytypes.NewParams(tc.policy))
+			require.NoError(t, err)
+
+			err = checker.CheckMsgs(ctx, tc.msgs)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgPolicyRouter(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	router := ante.NewMsgPolicyRouter(gaiaApp.MsgServiceRouter(), gaiaApp.MsgPolicyChecker)
+
+	from := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	msg := banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+
+	err := gaiaApp.MsgPolicyKeeper.SetParams(ctx, msgpolicytypes.NewParams(msgpolicytypes.MsgPolicy{
+		Name:     "staked-senders",
+		TypeUrls: []string{sdk.MsgTypeURL(msg)},
+		Enabled:  true,
+		MinStake: math.OneInt(),
+	}))
+	require.NoError(t, err)
+
+	handler := router.Handler(msg)
+	require.NotNil(t, handler)
+	_, err = handler(ctx, msg)
+	require.ErrorIs(t, err, gaiaerrors.ErrInsufficientStake)
+
+	require.Nil(t, router.HandlerByTypeURL("/gaia.unknown.MsgUnknown"))
+}
+
+// Test that the messages routed while a transaction is executed count towards
+// its MaxPerTx, and that the messages nested in it are not counted twice
+func TestMsgPolicyRouterMaxPerTx(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	router := ante.NewMsgPolicyRouter(gaiaApp.MsgServiceRouter(), gaiaApp.MsgPolicyChecker)
+
+	// this account was created during setup and has a balance
+	acc, err := gaiaApp.AccountKeeper.Accounts.Indexes.Number.MatchExact(ctx, 0)
+	require.NoError(t, err)
+	from := sdk.AccAddress(acc)
+	send := func() *banktypes.MsgSend {
+		return banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	}
+
+	err = gaiaApp.MsgPolicyKeeper.SetParams(ctx, msgpolicytypes.NewParams(msgpolicytypes.MsgPolicy{
+		Name:     "max-sends",
+		TypeUrls: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		Enabled:  true,
+		MinStake: math.ZeroInt(),
+		MaxPerTx: 1,
+	}))
+	require.NoError(t, err)
+
+	// the send nested in the MsgExec of the tx is counted once
+	txCtx := ante.WithMsgPolicyCounts(ctx)
+	exec := wrapInExec(t, send())
+	require.NoError(t, gaiaApp.MsgPolicyChecker.CheckMsgs(txCtx, []sdk.Msg{exec}))
+	nested, err := exec.GetMessages()
+	require.NoError(t, err)
+	_, err = router.Handler(nested[0])(txCtx, nested[0])
+	require.NoError(t, err)
+
+	// a send routed by the same tx exceeds its MaxPerTx
+	msg := send()
+	_, err = router.Handler(msg)(txCtx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// the counts are reset for the next tx
+	_, err = router.Handler(msg)(ante.WithMsgPolicyCounts(ctx), msg)
+	require.NoError(t, err)
+}
+
+// icaHost is an IBC application routing a message for every packet it
+// receives, like the ICA host executing the transaction of a packet.
+type icaHost struct {
+	porttypes.IBCModule
+	router ante.MsgPolicyRouter
+	msg    func() sdk.Msg
+}
+
+func (a icaHost) OnRecvPacket(ctx sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	msg := a.msg()
+	if _, err := a.router.Handler(msg)(ctx, msg); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+// Test that the messages routed for the packets batched in a transaction do not
+// share their counts, and that failed routed messages are not counted
+func TestMsgPolicyMaxPerTxScope(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	router := ante.NewMsgPolicyRouter(gaiaApp.MsgServiceRouter(), gaiaApp.MsgPolicyChecker)
+
+	// this account was created during setup and has a balance
+	acc, err := gaiaApp.AccountKeeper.Accounts.Indexes.Number.MatchExact(ctx, 0)
+	require.NoError(t, err)
+	from := sdk.AccAddress(acc)
+	send := func() sdk.Msg {
+		return banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	}
+
+	err = gaiaApp.MsgPolicyKeeper.SetParams(ctx, msgpolicytypes.NewParams(msgpolicytypes.MsgPolicy{
+		Name:     "max-sends",
+		TypeUrls: []string{sdk.MsgTypeURL(&banktypes.MsgSend{})},
+		Enabled:  true,
+		MinStake: math.ZeroInt(),
+		MaxPerTx: 1,
+	}))
+	require.NoError(t, err)
+
+	// two ICA packets received in one tx
+	host := icaHost{router: router, msg: send}
+	txCtx := ante.WithMsgPolicyCounts(ctx)
+	middleware := ante.NewMsgPolicyIBCMiddleware(host)
+	require.True(t, middleware.OnRecvPacket(txCtx, "", channeltypes.Packet{Sequence: 1}, nil).Success())
+	require.True(t, middleware.OnRecvPacket(txCtx, "", channeltypes.Packet{Sequence: 2}, nil).Success())
+
+	// without the middleware, they share the counts of the tx
+	txCtx = ante.WithMsgPolicyCounts(ctx)
+	require.True(t, host.OnRecvPacket(txCtx, "", channeltypes.Packet{Sequence: 1}, nil).Success())
+	require.False(t, host.OnRecvPacket(txCtx, "", channeltypes.Packet{Sequence: 2}, nil).Success())
+
+	// a failed send does not count
+	txCtx = ante.WithMsgPolicyCounts(ctx)
+	tooMuch := banktypes.NewMsgSend(from, from, sdk.NewCoins(sdk.NewInt64Coin("unknown", 1)))
+	_, err = router.Handler(tooMuch)(txCtx, tooMuch)
+	require.ErrorIs(t, err, sdkerrors.ErrInsufficientFunds)
+	msg := send()
+	_, err = router.Handler(msg)(txCtx, msg)
+	require.NoError(t, err)
+	msg = send()
+	_, err = router.Handler(msg)(txCtx, msg)
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+}
@@ -0,0 +1,152 @@
+package ante
+
+import (
+	"reflect"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	gaiaerrors "github.com/cosmos/gaia/v29/types/errors"
+)
+
+var maxWrappedMessageDepth = 20 // maximum depth of nested messages allowed
+
+// NestedMsg is a message reached while walking the messages of a transaction.
+type NestedMsg struct {
+	Msg sdk.Msg
+	// Depth is 0 for messages included directly in the transaction and is
+	// incremented for every wrapping message.
+	Depth int
+	// Remote is set for messages that are not executed on this chain, i.e.
+	// the contents of an ICA controller MsgSendTx packet, which run on the
+	// host chain.
+	Remote bool
+}
+
+// NestedMsgWalker visits every message carried by a transaction, including
+// messages packed into google.protobuf.Any fields of other messages
+// (authz MsgExec, gov v1 MsgSubmitProposal, or any other message registered
+// in the interface registry that implements UnpackInterfacesMessage) and the
+// messages serialized into the packet data of an ICA controller MsgSendTx.
+//
+// Payloads that are not protobuf messages, such as the JSON of a wasm
+// MsgExecuteContract, are opaque to the walker; messages dispatched by
+// contracts are inspected by the wasm messenger decorators instead.
+type NestedMsgWalker struct {
+	cdc codec.BinaryCodec
+}
+
+func NewNestedMsgWalker(cdc codec.BinaryCodec) NestedMsgWalker {
+	return NestedMsgWalker{cdc: cdc}
+}
+
+// Walk calls fn on each message in msgs and, depth-first, on every message
+// nested inside it. Wrapping messages are visited before their contents.
+// It fails with ErrNestedMessageLimitExceeded once a message is found at a
+// depth of maxWrappedMessageDepth or more.
+func (w NestedMsgWalker) Walk(msgs []sdk.Msg, fn func(NestedMsg) error) error {
+	for _, msg := range msgs {
+		if err := w.walk(NestedMsg{Msg: msg}, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (w NestedMsgWalker) walk(nested NestedMsg, fn func(NestedMsg) error) error {
+	if nested.Depth >= maxWrappedMessageDepth {
+		return errorsmod.Wrap(gaiaerrors.ErrNestedMessageLimitExceeded, "too many wrapped sdk messages")
+	}
+	if err := fn(nested); err != nil {
+		return err
+	}
+
+	inner, err := w.innerMsgs(nested.Msg)
+	if err != nil {
+		return err
+	}
+	_, isICA := nested.Msg.(*icacontrollertypes.MsgSendTx)
+	for _, msg := range inner {
+		child := NestedMsg{
+			Msg:    msg,
+			Depth:  nested.Depth + 1,
+			Remote: nested.Remote || isICA,
+		}
+		if err := w.walk(child, fn); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// innerMsgs returns the messages directly wrapped by msg.
+func (w NestedMsgWalker) innerMsgs(msg sdk.Msg) ([]sdk.Msg, error) {
+	if sendTx, ok := msg.(*icacontrollertypes.MsgSendTx); ok {
+		return w.icaPacketMsgs(sendTx.PacketData), nil
+	}
+
+	unpackable, ok := msg.(codectypes.UnpackInterfacesMessage)
+	if !ok {
+		return nil, nil
+	}
+	collector := &msgCollector{unpacker: w.cdc}
+	if err := unpackable.UnpackInterfaces(collector); err != nil {
+		return nil, errorsmod.Wrapf(gaiaerrors.ErrUnauthorized, "cannot unpack messages nested in %s: %s", sdk.MsgTypeURL(msg), err)
+	}
+	return collector.msgs, nil
+}
+
+// icaPacketMsgs decodes the messages of an ICA execute tx packet. Packet data
+// the host would reject anyway is treated as opaque and yields no messages.
+func (w NestedMsgWalker) icaPacketMsgs(data icatypes.InterchainAccountPacketData) []sdk.Msg {
+	if data.Type != icatypes.EXECUTE_TX {
+		return nil
+	}
+
+	var cosmosTx icatypes.CosmosTx
+	if err := w.cdc.Unmarshal(data.Data, &cosmosTx); err != nil {
+		jsonCdc, ok := w.cdc.(codec.JSONCodec)
+		if !ok || jsonCdc.UnmarshalJSON(data.Data, &cosmosTx) != nil {
+			return nil
+		}
+	}
+
+	msgs := make([]sdk.Msg, 0, len(cosmosTx.Messages))
+	for _, msgAny := range cosmosTx.Messages {
+		if msg, ok := msgAny.GetCachedValue().(sdk.Msg); ok {
+			msgs = append(msgs, msg)
+		}
+	}
+	return msgs
+}
+
+// msgType is the type of the interface messages are unpacked into.
+var msgType = reflect.TypeOf((*sdk.Msg)(nil)).Elem()
+
+// msgCollector is an AnyUnpacker that records the messages it unpacks. Only
+// the Anys unpacked into an sdk.Msg are recorded: since sdk.Msg is an alias of
+// proto.Message, the values of the other Anys, such as authz authorizations or
+// public keys, would otherwise be taken for messages too.
+type msgCollector struct {
+	unpacker codectypes.AnyUnpacker
+	msgs     []sdk.Msg
+}
+
+func (c *msgCollector) UnpackAny(msgAny *codectypes.Any, iface interface{}) error {
+	if err := c.unpacker.UnpackAny(msgAny, iface); err != nil {
+		return err
+	}
+	if msgAny == nil || reflect.TypeOf(iface).Elem() != msgType {
+		return nil
+	}
+	if msg, ok := reflect.ValueOf(iface).Elem().Interface().(sdk.Msg); ok && msg != nil {
+		c.msgs = append(c.msgs, msg)
+	}
+	return nil
+}
@@ -0,0 +1,167 @@
+package ante_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	icacontrollertypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/controller/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/ed25519"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/x/authz"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govv1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	"github.com/cosmos/gaia/v29/ante"
+	"github.com/cosmos/gaia/v29/app/helpers"
+	gaiaerrors "github.com/cosmos/gaia/v29/types/errors"
+)
+
+func wrapInExec(t *testing.T, msgs ...sdk.Msg) *authz.MsgExec {
+	t.Helper()
+	anys := make([]*codectypes.Any, len(msgs))
+	for i, msg := range msgs {
+		anyMsg, err := codectypes.NewAnyWithValue(msg)
+		require.NoError(t, err)
+		anys[i] = anyMsg
+	}
+	return &authz.MsgExec{Grantee: sdk.AccAddress{}.String(), Msgs: anys}
+}
+
+func wrapInICASendTx(t *testing.T, cdc codec.Codec, msgs ...proto.Message) *icacontrollertypes.MsgSendTx {
+	t.Helper()
+	data, err := icatypes.SerializeCosmosTx(cdc, msgs, icatypes.EncodingProtobuf)
+	require.NoError(t, err)
+	packetData := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: data}
+	return icacontrollertypes.NewMsgSendTx(sdk.AccAddress{}.String(), "connection-0", 100, packetData)
+}
+
+func TestNestedMsgWalker(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	cdc := gaiaApp.AppCodec()
+	walker := ante.NewNestedMsgWalker(cdc)
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	send := banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+	vote := govv1.NewMsgVote(addr, 0, govv1.OptionYes, "")
+
+	proposal, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{wrapInExec(t, vote)},
+		sdk.NewCoins(),
+		addr.String(),
+		"", "title", "summary", false,
+	)
+	require.NoError(t, err)
+	sendTx := wrapInICASendTx(t, cdc, send)
+
+	var visited []ante.NestedMsg
+	err = walker.Walk([]sdk.Msg{proposal, sendTx}, func(nested ante.NestedMsg) error {
+		visited = append(visited, nested)
+		return nil
+	})
+	require.NoError(t, err)
+
+	expected := []struct {
+		typeURL string
+		depth   int
+		remote  bool
+	}{
+		{sdk.MsgTypeURL(proposal), 0, false},
+		{sdk.MsgTypeURL(&authz.MsgExec{}), 1, false},
+		{sdk.MsgTypeURL(vote), 2, false},
+		{sdk.MsgTypeURL(sendTx), 0, false},
+		{sdk.MsgTypeURL(send), 1, true},
+	}
+	require.Len(t, visited, len(expected))
+	for i, exp := range expected {
+		require.Equal(t, exp.typeURL, sdk.MsgTypeURL(visited[i].Msg), "message %d", i)
+		require.Equal(t, exp.depth, visited[i].Depth, "message %d", i)
+		require.Equal(t, exp.remote, visited[i].Remote, "message %d", i)
+	}
+}
+
+// Test that the values of the Anys that are not messages, such as authz
+// authorizations and public keys, are not visited
+func TestNestedMsgWalkerIgnoresNonMsgAnys(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	walker := ante.NewNestedMsgWalker(gaiaApp.AppCodec())
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	grant, err := authz.NewMsgGrant(addr, addr, authz.NewGenericAuthorization(sdk.MsgTypeURL(&banktypes.MsgSend{})), nil)
+	require.NoError(t, err)
+	createValidator, err := stakingtypes.NewMsgCreateValidator(
+		sdk.ValAddress(addr).String(),
+		ed25519.GenPrivKey().PubKey(),
+		sdk.NewInt64Coin("uatom", 1),
+		stakingtypes.NewDescription("moniker", "", "", "", ""),
+		stakingtypes.NewCommissionRates(math.LegacyZeroDec(), math.LegacyZeroDec(), math.LegacyZeroDec()),
+		math.OneInt(),
+	)
+	require.NoError(t, err)
+	exec := wrapInExec(t, grant, createValidator)
+
+	var visited []string
+	err = walker.Walk([]sdk.Msg{exec}, func(nested ante.NestedMsg) error {
+		visited = append(visited, sdk.MsgTypeURL(nested.Msg))
+		return nil
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		sdk.MsgTypeURL(exec),
+		sdk.MsgTypeURL(grant),
+		sdk.MsgTypeURL(createValidator),
+	}, visited)
+}
+
+func TestNestedMsgWalkerDepthLimit(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	walker := ante.NewNestedMsgWalker(gaiaApp.AppCodec())
+
+	addr := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	var msg sdk.Msg = banktypes.NewMsgSend(addr, addr, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+	for i := 0; i < 19; i++ {
+		msg = wrapInExec(t, msg)
+	}
+	noop := func(ante.NestedMsg) error { return nil }
+	require.NoError(t, walker.Walk([]sdk.Msg{msg}, noop))
+
+	msg = wrapInExec(t, msg)
+	err := walker.Walk([]sdk.Msg{msg}, noop)
+	require.ErrorIs(t, err, gaiaerrors.ErrNestedMessageLimitExceeded)
+}
+
+// Test that the GovVoteDecorator inspects votes nested in proposals and
+// ignores votes sent to an ICA host chain
+func TestVoteSpamDecoratorNestedMsgs(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(true, tmproto.Header{})
+	cdc := gaiaApp.AppCodec()
+	decorator := ante.NewGovVoteDecorator(cdc, gaiaApp.StakingKeeper)
+
+	// voter without any stake
+	voter := sdk.AccAddress(ed25519.GenPrivKey().PubKey().Address())
+	vote := govv1.NewMsgVote(voter, 0, govv1.OptionYes, "")
+
+	proposal, err := govv1.NewMsgSubmitProposal(
+		[]sdk.Msg{vote},
+		sdk.NewCoins(sdk.NewCoin("uatom", math.NewInt(1))),
+		voter.String(),
+		"", "title", "summary", false,
+	)
+	require.NoError(t, err)
+	err = decorator.ValidateVoteMsgs(ctx, []sdk.Msg{proposal})
+	require.Error(t, err)
+
+	err = decorator.ValidateVoteMsgs(ctx, []sdk.Msg{wrapInICASendTx(t, cdc, vote)})
+	require.NoError(t, err)
+}
@@ -2,6 +2,7 @@ package gaia
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"io"
 	"net/http"
@@ -9,6 +10,7 @@ import (
 	"path/filepath"
 
 	"github.com/gorilla/mux"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/rakyll/statik/fs"
 	feemarketkeeper "github.com/skip-mev/feemarket/x/feemarket/keeper"
 
@@ -72,7 +74,10 @@ import (
 	"github.com/cosmos/gaia/v29/app/keepers"
 	"github.com/cosmos/gaia/v29/app/upgrades"
 	v290 "github.com/cosmos/gaia/v29/app/upgrades/v29_0_0"
-	legacyics "github.com/cosmos/gaia/v29/x/legacy/ics"
+	"github.com/cosmos/gaia/v29/pkg/txoutcome"
+	feeestimate "github.com/cosmos/gaia/v29/x/feedenoms/estimate"
+	"github.com/cosmos/gaia/v29/x/legacy"
+	metaprotocolsindexer "github.com/cosmos/gaia/v29/x/metaprotocols/indexer"
 )
 
 var (
@@ -80,6 +85,7 @@ var (
 	DefaultNodeHome string
 
 	Upgrades = []upgrades.Upgrade{v290.Upgrade}
+	Forks    = []upgrades.Fork{}
 )
 
 var (
@@ -107,6 +113,12 @@ type GaiaApp struct { //nolint: revive
 	// simulation manager
 	sm           *module.SimulationManager
 	configurator module.Configurator
+
+	// metaprotocolsIndexer is nil unless enabled in the node configuration
+	metaprotocolsIndexer *metaprotocolsindexer.Indexer
+	// txOutcomes is nil unless a tx outcome sink is enabled in the node
+	// configuration
+	txOutcomes *txoutcome.Recorder
 }
 
 func init() {
@@ -153,7 +165,7 @@ func NewGaiaApp(
 
 	std.RegisterLegacyAminoCodec(legacyAmino)
 	std.RegisterInterfaces(interfaceRegistry)
-	legacyics.RegisterInterfaces(interfaceRegistry)
+	legacy.RegisterInterfaces(interfaceRegistry, legacyArchives...)
 
 	bApp := baseapp.NewBaseApp(
 		appName,
@@ -175,6 +187,42 @@ func NewGaiaApp(
 		interfaceRegistry: interfaceRegistry,
 	}
 
+	// The metaprotocols indexer keeps its own database next to the
+	// application database and never writes to the application state.
+	indexerConfig, err := metaprotocolsindexer.ReadConfig(appOpts)
+	if err != nil {
+		panic("error while reading metaprotocols config: " + err.Error())
+	}
+	if indexerConfig.IndexEnabled {
+		indexerDB, err := dbm.NewDB(metaprotocolsindexer.DBName, server.GetAppDBBackend(appOpts), filepath.Join(homePath, "data"))
+		if err != nil {
+			panic(fmt.Sprintf("failed to open metaprotocols index: %s", err))
+		}
+		app.metaprotocolsIndexer = metaprotocolsindexer.NewIndexer(indexerDB, appCodec, txConfig.TxDecoder(), logger)
+
+		streamingManager := bApp.StreamingManager()
+		streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, app.metaprotocolsIndexer)
+		bApp.SetStreamingManager(streamingManager)
+	}
+
+	// The tx outcome sinks are fed by OnTxSucceeded and OnTxFailed, called
+	// for the transactions of every finalized block.
+	txOutcomeConfig, err := txoutcome.ReadConfig(appOpts)
+	if err != nil {
+		panic("error while reading tx_outcomes config: " + err.Error())
+	}
+	txOutcomeSinks, err := txoutcome.NewSinks(txOutcomeConfig, homePath, prometheus.DefaultRegisterer, logger)
+	if err != nil {
+		panic(fmt.Sprintf("failed to open tx outcome sinks: %s", err))
+	}
+	if len(txOutcomeSinks) > 0 {
+		app.txOutcomes = txoutcome.NewRecorder(txConfig.TxDecoder(), logger, txOutcomeSinks...)
+
+		streamingManager := bApp.StreamingManager()
+		streamingManager.ABCIListeners = append(streamingManager.ABCIListeners, txoutcome.NewListener(app))
+		bApp.SetStreamingManager(streamingManager)
+	}
+
 	moduleAccountAddresses := app.ModuleAccountAddrs()
 
 	// Setup keepers
@@ -291,6 +339,8 @@ func NewGaiaApp(
 			IBCkeeper:             app.IBCKeeper,
 			StakingKeeper:         app.StakingKeeper,
 			FeeMarketKeeper:       app.FeeMarketKeeper,
+			MsgPolicyChecker:      app.MsgPolicyChecker,
+			MetaprotocolsKeeper:   app.MetaprotocolsKeeper,
 			WasmConfig:            &wasmConfig,
 			TXCounterStoreService: runtime.NewKVStoreService(app.GetKey(wasmtypes.StoreKey)),
 			TxFeeChecker: func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
@@ -306,6 +356,7 @@ func NewGaiaApp(
 		AccountKeeper:   app.AccountKeeper,
 		BankKeeper:      app.BankKeeper,
 		FeeMarketKeeper: app.FeeMarketKeeper,
+		FeeDenomsKeeper: app.FeeDenomsKeeper,
 	}
 	postHandler, err := NewPostHandler(postHandlerOptions)
 	if err != nil {
@@ -334,6 +385,10 @@ func NewGaiaApp(
 	app.setupUpgradeHandlers()
 	app.setupUpgradeStoreLoaders()
 
+	if err := validateForks(Forks); err != nil {
+		panic(fmt.Sprintf("invalid forks: %s", err))
+	}
+
 	// At startup, after all modules have been registered, check that all prot
 	// annotations are correct.
 	protoFiles, err := proto.MergedRegistry()
@@ -369,6 +424,19 @@ func NewGaiaApp(
 // Name returns the name of the App
 func (app *GaiaApp) Name() string { return app.BaseApp.Name() }
 
+// Close closes the application databases, including the metaprotocols index,
+// and the tx outcome sinks.
+func (app *GaiaApp) Close() error {
+	err := app.BaseApp.Close()
+	if app.metaprotocolsIndexer != nil {
+		err = errors.Join(err, app.metaprotocolsIndexer.Close())
+	}
+	if app.txOutcomes != nil {
+		err = errors.Join(err, app.txOutcomes.Close())
+	}
+	return err
+}
+
 // PreBlocker application updates every pre block
 func (app *GaiaApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*sdk.ResponsePreBlock, error) {
 	return app.mm.PreBlock(ctx)
@@ -376,6 +444,8 @@ func (app *GaiaApp) PreBlocker(ctx sdk.Context, _ *abci.RequestFinalizeBlock) (*
 
 // BeginBlocker application updates every begin block
 func (app *GaiaApp) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
+	BeginBlockForks(ctx, app)
+
 	return app.mm.BeginBlock(ctx)
 }
 
@@ -479,6 +549,9 @@ func (app *GaiaApp) RegisterAPIRoutes(apiSvr *api.Server, apiConfig config.APICo
 	// Register nodeservice grpc-gateway routes.
 	nodeservice.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
 
+	// Register fee estimation grpc-gateway routes.
+	feeestimate.RegisterGRPCGatewayRoutes(clientCtx, apiSvr.GRPCGatewayRouter)
+
 	// register swagger API from root so that other applications can override easily
 	if err := server.RegisterSwaggerAPI(apiSvr.ClientCtx, apiSvr.Router, apiConfig.Swagger); err != nil {
 		panic(err)
@@ -493,6 +566,14 @@ func (app *GaiaApp) RegisterNodeService(clientCtx client.Context, cfg config.Con
 // RegisterTxService implements the Application.RegisterTxService method.
 func (app *GaiaApp) RegisterTxService(clientCtx client.Context) {
 	authtx.RegisterTxService(app.GRPCQueryRouter(), clientCtx, app.Simulate, app.interfaceRegistry)
+	feeestimate.RegisterService(app.GRPCQueryRouter(), feeestimate.NewServer(
+		app.appCodec,
+		app.txConfig,
+		app.Simulate,
+		app.AccountKeeper,
+		app.FeeMarketKeeper,
+		app.GaiaBankKeeper,
+	))
 }
 
 // RegisterTendermintService implements the Application.RegisterTendermintService method.
@@ -549,10 +630,20 @@ func RegisterSwaggerAPI(rtr *mux.Router) {
 	rtr.PathPrefix("/swagger/").Handler(http.StripPrefix("/swagger/", staticServer))
 }
 
-func (app *GaiaApp) OnTxSucceeded(_ sdk.Context, _, _ string, _ []byte, _ []byte) {
+// OnTxSucceeded records the outcome of a successful transaction of a
+// finalized block in the tx outcome sinks.
+func (app *GaiaApp) OnTxSucceeded(ctx sdk.Context, txIndex int, txBytes []byte, result *abci.ExecTxResult) {
+	if app.txOutcomes != nil {
+		app.txOutcomes.Record(ctx, txIndex, txBytes, result)
+	}
 }
 
-func (app *GaiaApp) OnTxFailed(_ sdk.Context, _, _ string, _ []byte, _ []byte) {
+// OnTxFailed records the outcome of a failed transaction of a finalized block
+// in the tx outcome sinks.
+func (app *GaiaApp) OnTxFailed(ctx sdk.Context, txIndex int, txBytes []byte, result *abci.ExecTxResult) {
+	if app.txOutcomes != nil {
+		app.txOutcomes.Record(ctx, txIndex, txBytes, result)
+	}
 }
 
 // AutoCliOpts returns the autocli options for the app.
@@ -4,10 +4,15 @@ import (
 	serverconfig "github.com/cosmos/cosmos-sdk/server/config"
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
+
+	"github.com/cosmos/gaia/v29/pkg/txoutcome"
+	metaprotocolsindexer "github.com/cosmos/gaia/v29/x/metaprotocols/indexer"
 )
 
 type AppConfig struct {
 	serverconfig.Config
 
-	Wasm wasmtypes.NodeConfig `mapstructure:"wasm"`
+	Wasm          wasmtypes.NodeConfig        `mapstructure:"wasm"`
+	Metaprotocols metaprotocolsindexer.Config `mapstructure:"metaprotocols"`
+	TxOutcomes    txoutcome.Config            `mapstructure:"tx_outcomes"`
 }
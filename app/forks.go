@@ -0,0 +1,53 @@
+package gaia
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/app/upgrades"
+)
+
+// BeginBlockForks runs the BeginForkLogic of the fork scheduled at the
+// current height, if any.
+//
+// The logic of a fork runs once per node: the block at the fork height is
+// only executed again if it was not committed, e.g. after a crash, and a node
+// state synced from a snapshot taken after the fork height restores the
+// state the fork logic wrote.
+func BeginBlockForks(ctx sdk.Context, app *GaiaApp) {
+	for _, fork := range Forks {
+		if ctx.BlockHeight() == fork.UpgradeHeight {
+			ctx.Logger().Info("applying fork", "name", fork.UpgradeName, "height", fork.UpgradeHeight)
+			fork.BeginForkLogic(ctx, &app.AppKeepers)
+			return
+		}
+	}
+}
+
+// validateForks checks that every fork has a name, a positive height and
+// logic, and that forks have distinct names and heights.
+func validateForks(forks []upgrades.Fork) error {
+	names := make(map[string]struct{}, len(forks))
+	heights := make(map[int64]struct{}, len(forks))
+	for _, fork := range forks {
+		if fork.UpgradeName == "" {
+			return fmt.Errorf("fork at height %d has no name", fork.UpgradeHeight)
+		}
+		if fork.UpgradeHeight <= 0 {
+			return fmt.Errorf("fork %s: height must be positive", fork.UpgradeName)
+		}
+		if fork.BeginForkLogic == nil {
+			return fmt.Errorf("fork %s has no logic", fork.UpgradeName)
+		}
+		if _, ok := names[fork.UpgradeName]; ok {
+			return fmt.Errorf("duplicate fork %s", fork.UpgradeName)
+		}
+		names[fork.UpgradeName] = struct{}{}
+		if _, ok := heights[fork.UpgradeHeight]; ok {
+			return fmt.Errorf("fork %s: duplicate fork height %d", fork.UpgradeName, fork.UpgradeHeight)
+		}
+		heights[fork.UpgradeHeight] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,162 @@
+package gaia_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/snapshots"
+	snapshottypes "cosmossdk.io/store/snapshots/types"
+
+	"github.com/cosmos/cosmos-sdk/baseapp"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	gaia "github.com/cosmos/gaia/v29/app"
+	gaiahelpers "github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/app/keepers"
+	"github.com/cosmos/gaia/v29/app/upgrades"
+)
+
+const forkHeight = 4
+
+var forkRecipient = sdk.AccAddress("fork-recipient")
+
+// setForks sets a fork at forkHeight minting a coin to forkRecipient, and
+// returns the number of times its logic ran.
+func setForks(t *testing.T) *int {
+	t.Helper()
+
+	runs := new(int)
+	forks := gaia.Forks
+	gaia.Forks = []upgrades.Fork{{
+		UpgradeName:   "test-fork",
+		UpgradeHeight: forkHeight,
+		BeginForkLogic: func(ctx sdk.Context, keepers *keepers.AppKeepers) {
+			*runs++
+			coins := sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.OneInt()))
+			require.NoError(t, keepers.BankKeeper.MintCoins(ctx, minttypes.ModuleName, coins))
+			require.NoError(t, keepers.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, forkRecipient, coins))
+		},
+	}}
+	t.Cleanup(func() { gaia.Forks = forks })
+	return runs
+}
+
+// finalizeBlocks finalizes and commits the blocks of gaiaApp up to height.
+func finalizeBlocks(t *testing.T, gaiaApp *gaia.GaiaApp, height int64) {
+	t.Helper()
+
+	for gaiaApp.LastBlockHeight() < height {
+		_, err := gaiaApp.FinalizeBlock(&abci.RequestFinalizeBlock{
+			Height: gaiaApp.LastBlockHeight() + 1,
+			Hash:   gaiaApp.LastCommitID().Hash,
+		})
+		require.NoError(t, err)
+		_, err = gaiaApp.Commit()
+		require.NoError(t, err)
+	}
+}
+
+// requireForkState checks that the state written by the fork logic is in
+// the state of gaiaApp.
+func requireForkState(t *testing.T, gaiaApp *gaia.GaiaApp) {
+	t.Helper()
+
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: gaiaApp.LastBlockHeight()})
+	require.Equal(t, math.OneInt(), gaiaApp.BankKeeper.GetBalance(ctx, forkRecipient, sdk.DefaultBondDenom).Amount)
+}
+
+func TestForks(t *testing.T) {
+	runs := setForks(t)
+
+	db := dbm.NewMemDB()
+	snapshotStore, err := snapshots.NewStore(dbm.NewMemDB(), t.TempDir())
+	require.NoError(t, err)
+	gaiaApp := gaiahelpers.SetupWithDB(t, db, baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0)))
+	_, err = gaiaApp.Commit()
+	require.NoError(t, err)
+
+	// the fork logic runs at the fork height only
+	finalizeBlocks(t, gaiaApp, forkHeight-1)
+	require.Zero(t, *runs)
+	finalizeBlocks(t, gaiaApp, forkHeight)
+	require.Equal(t, 1, *runs)
+	finalizeBlocks(t, gaiaApp, forkHeight+2)
+	require.Equal(t, 1, *runs)
+	requireForkState(t, gaiaApp)
+
+	// a restarted node does not run it again
+	restartedApp := gaiahelpers.NewAppWithDB(db)
+	require.Equal(t, int64(forkHeight+2), restartedApp.LastBlockHeight())
+	finalizeBlocks(t, restartedApp, forkHeight+4)
+	require.Equal(t, 1, *runs)
+	requireForkState(t, restartedApp)
+
+	for _, tc := range []struct {
+		name           string
+		snapshotHeight uint64
+		expRuns        int
+	}{
+		{"state sync before the fork", forkHeight - 1, 1},
+		{"state sync after the fork", forkHeight + 1, 0},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			snapshot, err := gaiaApp.SnapshotManager().Create(tc.snapshotHeight)
+			require.NoError(t, err)
+			abciSnapshot, err := snapshot.ToABCI()
+			require.NoError(t, err)
+
+			syncedApp := gaiahelpers.NewAppWithDB(dbm.NewMemDB(), baseapp.SetSnapshot(snapshotStore, snapshottypes.NewSnapshotOptions(0, 0)))
+			offer, err := syncedApp.OfferSnapshot(&abci.RequestOfferSnapshot{Snapshot: &abciSnapshot})
+			require.NoError(t, err)
+			require.Equal(t, abci.ResponseOfferSnapshot_ACCEPT, offer.Result)
+			for index := uint32(0); index < snapshot.Chunks; index++ {
+				chunk, err := gaiaApp.LoadSnapshotChunk(&abci.RequestLoadSnapshotChunk{Height: snapshot.Height, Format: snapshot.Format, Chunk: index})
+				require.NoError(t, err)
+				applied, err := syncedApp.ApplySnapshotChunk(&abci.RequestApplySnapshotChunk{Index: index, Chunk: chunk.Chunk})
+				require.NoError(t, err)
+				require.Equal(t, abci.ResponseApplySnapshotChunk_ACCEPT, applied.Result)
+			}
+			require.Equal(t, int64(tc.snapshotHeight), syncedApp.LastBlockHeight())
+
+			before := *runs
+			finalizeBlocks(t, syncedApp, forkHeight+2)
+			require.Equal(t, tc.expRuns, *runs-before)
+			requireForkState(t, syncedApp)
+		})
+	}
+}
+
+func TestInvalidForks(t *testing.T) {
+	logic := func(sdk.Context, *keepers.AppKeepers) {}
+	for _, tc := range []struct {
+		name  string
+		forks []upgrades.Fork
+	}{
+		{"no name", []upgrades.Fork{{UpgradeHeight: 1, BeginForkLogic: logic}}},
+		{"zero height", []upgrades.Fork{{UpgradeName: "a", BeginForkLogic: logic}}},
+		{"no logic", []upgrades.Fork{{UpgradeName: "a", UpgradeHeight: 1}}},
+		{"duplicate name", []upgrades.Fork{
+			{UpgradeName: "a", UpgradeHeight: 1, BeginForkLogic: logic},
+			{UpgradeName: "a", UpgradeHeight: 2, BeginForkLogic: logic},
+		}},
+		{"duplicate height", []upgrades.Fork{
+			{UpgradeName: "a", UpgradeHeight: 1, BeginForkLogic: logic},
+			{UpgradeName: "b", UpgradeHeight: 1, BeginForkLogic: logic},
+		}},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			forks := gaia.Forks
+			gaia.Forks = tc.forks
+			t.Cleanup(func() { gaia.Forks = forks })
+			require.Panics(t, func() { gaiahelpers.NewAppWithDB(dbm.NewMemDB()) })
+		})
+	}
+}
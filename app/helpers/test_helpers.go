@@ -17,6 +17,7 @@ import (
 	"cosmossdk.io/log"
 	"cosmossdk.io/math"
 
+	"github.com/cosmos/cosmos-sdk/baseapp"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
 	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
@@ -68,6 +69,14 @@ func (EmptyAppOptions) Get(_ string) interface{} { return nil }
 func Setup(t *testing.T) *gaiaapp.GaiaApp {
 	t.Helper()
 
+	return SetupWithDB(t, dbm.NewMemDB())
+}
+
+// SetupWithDB is Setup, with the state of the app stored in db, so that the
+// app can be restarted with NewAppWithDB.
+func SetupWithDB(t *testing.T, db dbm.DB, baseAppOptions ...func(*baseapp.BaseApp)) *gaiaapp.GaiaApp {
+	t.Helper()
+
 	privVal := mock.NewPV()
 	pubKey, err := privVal.GetPubKey()
 	require.NoError(t, err)
@@ -85,7 +94,7 @@ func Setup(t *testing.T) *gaiaapp.GaiaApp {
 		Coins:   sdk.NewCoins(sdk.NewCoin(sdk.DefaultBondDenom, math.NewInt(100000000000000))),
 	}
 	genesisAccounts := []authtypes.GenesisAccount{acc}
-	app := SetupWithGenesisValSet(t, valSet, genesisAccounts, balance)
+	app := setupWithGenesisValSet(t, NewAppWithDB(db, baseAppOptions...), valSet, genesisAccounts, balance)
 
 	return app
 }
@@ -97,8 +106,13 @@ func Setup(t *testing.T) *gaiaapp.GaiaApp {
 func SetupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *gaiaapp.GaiaApp {
 	t.Helper()
 
-	gaiaApp, genesisState := setup()
-	genesisState = genesisStateWithValSet(t, gaiaApp, genesisState, valSet, genAccs, balances...)
+	return setupWithGenesisValSet(t, NewAppWithDB(dbm.NewMemDB()), valSet, genAccs, balances...)
+}
+
+func setupWithGenesisValSet(t *testing.T, gaiaApp *gaiaapp.GaiaApp, valSet *tmtypes.ValidatorSet, genAccs []authtypes.GenesisAccount, balances ...banktypes.Balance) *gaiaapp.GaiaApp {
+	t.Helper()
+
+	genesisState := genesisStateWithValSet(t, gaiaApp, gaiaApp.ModuleBasics.DefaultGenesis(gaiaApp.AppCodec()), valSet, genAccs, balances...)
 
 	stateBytes, err := json.MarshalIndent(genesisState, "", " ")
 	require.NoError(t, err)
@@ -124,8 +138,9 @@ func SetupWithGenesisValSet(t *testing.T, valSet *tmtypes.ValidatorSet, genAccs
 	return gaiaApp
 }
 
-func setup() (*gaiaapp.GaiaApp, gaiaapp.GenesisState) {
-	db := dbm.NewMemDB()
+// NewAppWithDB returns a test GaiaApp loading its latest state from db, e.g.
+// to restart an app created by SetupWithDB.
+func NewAppWithDB(db dbm.DB, baseAppOptions ...func(*baseapp.BaseApp)) *gaiaapp.GaiaApp {
 	dir, err := os.MkdirTemp("", "gaia-test-app")
 	if err != nil {
 		panic(err)
@@ -136,7 +151,7 @@ func setup() (*gaiaapp.GaiaApp, gaiaapp.GenesisState) {
 	appOptions[server.FlagInvCheckPeriod] = 5
 	appOptions[server.FlagMinGasPrices] = "0uatom"
 
-	gaiaApp := gaiaapp.NewGaiaApp(
+	return gaiaapp.NewGaiaApp(
 		log.NewNopLogger(),
 		db,
 		nil,
@@ -145,8 +160,8 @@ func setup() (*gaiaapp.GaiaApp, gaiaapp.GenesisState) {
 		dir,
 		appOptions,
 		emptyWasmOpts,
+		baseAppOptions...,
 	)
-	return gaiaApp, gaiaApp.ModuleBasics.DefaultGenesis(gaiaApp.AppCodec())
 }
 
 func genesisStateWithValSet(t *testing.T,
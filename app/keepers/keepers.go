@@ -1,7 +1,6 @@
 package keepers
 
 import (
-	"errors"
 	"fmt"
 	"os"
 	"path/filepath"
@@ -10,7 +9,6 @@ import (
 	feemarketkeeper "github.com/skip-mev/feemarket/x/feemarket/keeper"
 	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
 
-	"github.com/cosmos/gogoproto/proto"
 	pfmrouter "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward"
 	pfmrouterkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/keeper"
 	pfmroutertypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
@@ -90,8 +88,27 @@ import (
 
 	"github.com/cosmos/gaia/v29/ante"
 	gaiaparams "github.com/cosmos/gaia/v29/app/params"
+	gaiabankkeeper "github.com/cosmos/gaia/v29/x/bank/keeper"
+	gaiabanktypes "github.com/cosmos/gaia/v29/x/bank/types"
+	feedenomskeeper "github.com/cosmos/gaia/v29/x/feedenoms/keeper"
+	feedenomstypes "github.com/cosmos/gaia/v29/x/feedenoms/types"
+	"github.com/cosmos/gaia/v29/x/icahostv2"
 	liquidkeeper "github.com/cosmos/gaia/v29/x/liquid/keeper"
 	liquidtypes "github.com/cosmos/gaia/v29/x/liquid/types"
+	metaprotocolskeeper "github.com/cosmos/gaia/v29/x/metaprotocols/keeper"
+	metaprotocolstypes "github.com/cosmos/gaia/v29/x/metaprotocols/types"
+	msgpolicykeeper "github.com/cosmos/gaia/v29/x/msgpolicy/keeper"
+	msgpolicytypes "github.com/cosmos/gaia/v29/x/msgpolicy/types"
+	"github.com/cosmos/gaia/v29/x/pfmparams"
+	pfmparamskeeper "github.com/cosmos/gaia/v29/x/pfmparams/keeper"
+	pfmparamstypes "github.com/cosmos/gaia/v29/x/pfmparams/types"
+	gaiaratelimit "github.com/cosmos/gaia/v29/x/ratelimit"
+	gaiatokenfactory "github.com/cosmos/gaia/v29/x/tokenfactory"
+	gaiatokenfactorykeeper "github.com/cosmos/gaia/v29/x/tokenfactory/keeper"
+	gaiatokenfactorytypes "github.com/cosmos/gaia/v29/x/tokenfactory/types"
+	wasmquerybindings "github.com/cosmos/gaia/v29/x/wasmquery/bindings"
+	wasmquerykeeper "github.com/cosmos/gaia/v29/x/wasmquery/keeper"
+	wasmquerytypes "github.com/cosmos/gaia/v29/x/wasmquery/types"
 )
 
 type AppKeepers struct {
@@ -101,18 +118,21 @@ type AppKeepers struct {
 	memKeys map[string]*storetypes.MemoryStoreKey
 
 	// keepers
-	AccountKeeper      authkeeper.AccountKeeper
-	BankKeeper         bankkeeper.Keeper
-	StakingKeeper      *stakingkeeper.Keeper
-	SlashingKeeper     slashingkeeper.Keeper
-	MintKeeper         mintkeeper.Keeper
-	DistrKeeper        distrkeeper.Keeper
-	LiquidKeeper       *liquidkeeper.Keeper
-	GovKeeper          *govkeeper.Keeper
-	UpgradeKeeper      *upgradekeeper.Keeper
-	ParamsKeeper       paramskeeper.Keeper //nolint:staticcheck
-	WasmKeeper         wasmkeeper.Keeper
-	TokenFactoryKeeper tokenfactorykeeper.Keeper
+	AccountKeeper       authkeeper.AccountKeeper
+	BankKeeper          bankkeeper.Keeper
+	GaiaBankKeeper      *gaiabankkeeper.Keeper
+	StakingKeeper       *stakingkeeper.Keeper
+	SlashingKeeper      slashingkeeper.Keeper
+	MintKeeper          mintkeeper.Keeper
+	DistrKeeper         distrkeeper.Keeper
+	LiquidKeeper        *liquidkeeper.Keeper
+	MsgPolicyKeeper     *msgpolicykeeper.Keeper
+	MetaprotocolsKeeper *metaprotocolskeeper.Keeper
+	GovKeeper           *govkeeper.Keeper
+	UpgradeKeeper       *upgradekeeper.Keeper
+	ParamsKeeper        paramskeeper.Keeper //nolint:staticcheck
+	WasmKeeper          wasmkeeper.Keeper
+	TokenFactoryKeeper  tokenfactorykeeper.Keeper
 	// IBC Keeper must be a pointer in the app, so we can SetRouter on it correctly
 	IBCKeeper             *ibckeeper.Keeper
 	WasmClientKeeper      ibcwasmkeeper.Keeper
@@ -124,10 +144,17 @@ type AppKeepers struct {
 	AuthzKeeper           authzkeeper.Keeper
 	ConsensusParamsKeeper consensusparamkeeper.Keeper
 	FeeMarketKeeper       *feemarketkeeper.Keeper
+	FeeDenomsKeeper       *feedenomskeeper.Keeper
+	WasmQueryKeeper       *wasmquerykeeper.Keeper
 
 	PFMRouterKeeper *pfmrouterkeeper.Keeper
+	PFMParamsKeeper *pfmparamskeeper.Keeper
 	RatelimitKeeper ratelimitkeeper.Keeper
 
+	GaiaTokenFactoryKeeper *gaiatokenfactorykeeper.Keeper
+
+	MsgPolicyChecker *ante.MsgPolicyChecker
+
 	// Modules
 	ICAModule       ica.AppModule
 	TransferModule  transfer.AppModule
@@ -200,16 +227,13 @@ func NewAppKeeper(
 		logger,
 	)
 
-	appKeepers.AuthzKeeper = authzkeeper.NewKeeper(
-		runtime.NewKVStoreService(appKeepers.keys[authzkeeper.StoreKey]),
+	appKeepers.GaiaBankKeeper = gaiabankkeeper.NewKeeper(
 		appCodec,
-		bApp.MsgServiceRouter(),
-		appKeepers.AccountKeeper,
+		runtime.NewKVStoreService(appKeepers.keys[gaiabanktypes.StoreKey]),
+		appKeepers.BankKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
-	// We need to set the bank keeper here otherwise risk a NPE in certain message handlers
-	appKeepers.AuthzKeeper = appKeepers.AuthzKeeper.SetBankKeeper(appKeepers.BankKeeper)
-
 	appKeepers.FeeGrantKeeper = feegrantkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[feegrant.StoreKey]),
@@ -226,6 +250,35 @@ func NewAppKeeper(
 		authcodec.NewBech32Codec(sdk.GetConfig().GetBech32ConsensusAddrPrefix()),
 	)
 
+	appKeepers.MetaprotocolsKeeper = metaprotocolskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[metaprotocolstypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
+	appKeepers.MsgPolicyKeeper = msgpolicykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[msgpolicytypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.MsgPolicyChecker = ante.NewMsgPolicyChecker(
+		appCodec,
+		appKeepers.MsgPolicyKeeper,
+		appKeepers.StakingKeeper,
+	)
+	// messages routed by authz, wasm and the ICA host are subject to the message policies
+	policyMsgRouter := ante.NewMsgPolicyRouter(bApp.MsgServiceRouter(), appKeepers.MsgPolicyChecker)
+
+	appKeepers.AuthzKeeper = authzkeeper.NewKeeper(
+		runtime.NewKVStoreService(appKeepers.keys[authzkeeper.StoreKey]),
+		appCodec,
+		policyMsgRouter,
+		appKeepers.AccountKeeper,
+	)
+
+	// We need to set the bank keeper here otherwise risk a NPE in certain message handlers
+	appKeepers.AuthzKeeper = appKeepers.AuthzKeeper.SetBankKeeper(appKeepers.BankKeeper)
+
 	appKeepers.DistrKeeper = distrkeeper.NewKeeper(
 		appCodec,
 		runtime.NewKVStoreService(appKeepers.keys[distrtypes.StoreKey]),
@@ -247,6 +300,14 @@ func NewAppKeeper(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	appKeepers.GaiaTokenFactoryKeeper = gaiatokenfactorykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[gaiatokenfactorytypes.StoreKey]),
+		appKeepers.TokenFactoryKeeper,
+		appKeepers.DistrKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	appKeepers.SlashingKeeper = slashingkeeper.NewKeeper(
 		appCodec,
 		legacyAmino,
@@ -279,10 +340,18 @@ func NewAppKeeper(
 		appCodec,
 		appKeepers.keys[feemarkettypes.StoreKey],
 		appKeepers.AccountKeeper,
-		&DefaultFeemarketDenomResolver{},
+		nil, // set below, the fee denoms keeper resolves the accepted fee denoms
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	appKeepers.FeeDenomsKeeper = feedenomskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[feedenomstypes.StoreKey]),
+		appKeepers.FeeMarketKeeper,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+	appKeepers.FeeMarketKeeper.SetDenomResolver(appKeepers.FeeDenomsKeeper)
+
 	// UpgradeKeeper must be created before IBCKeeper
 	appKeepers.UpgradeKeeper = upgradekeeper.NewKeeper(
 		skipUpgradeHeights,
@@ -394,7 +463,7 @@ func NewAppKeeper(
 		appKeepers.IBCKeeper.ChannelKeeper, // ICS4Wrapper
 		appKeepers.IBCKeeper.ChannelKeeper,
 		appKeepers.AccountKeeper,
-		bApp.MsgServiceRouter(),
+		policyMsgRouter,
 		bApp.GRPCQueryRouter(),
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
@@ -424,6 +493,12 @@ func NewAppKeeper(
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
+	appKeepers.PFMParamsKeeper = pfmparamskeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[pfmparamstypes.StoreKey]),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
+
 	// PFMRouterKeeper must be created before TransferKeeper
 	appKeepers.PFMRouterKeeper = pfmrouterkeeper.NewKeeper(
 		appCodec,
@@ -459,6 +534,22 @@ func NewAppKeeper(
 	// Register tokenfactory wasm bindings
 	tokenfactoryOpts := tokenfactorybindings.RegisterCustomPlugins(appKeepers.BankKeeper, &appKeepers.TokenFactoryKeeper)
 	wasmOpts = append(wasmOpts, tokenfactoryOpts...)
+	// Enforce the denom quota and charge the denom creation fee surcharge on
+	// the denoms created by contracts through the tokenfactory bindings
+	wasmOpts = append(wasmOpts, wasmkeeper.WithMessageHandlerDecorator(
+		gaiatokenfactory.CustomMessageDecorator(appKeepers.GaiaTokenFactoryKeeper),
+	))
+
+	// Register Gaia wasm bindings for the feemarket, rate limit and PFM state,
+	// passing the other custom queries to the tokenfactory bindings
+	gaiaBindingsOpts := wasmquerybindings.RegisterCustomPlugins(
+		appCodec,
+		appKeepers.FeeMarketKeeper,
+		appKeepers.RatelimitKeeper,
+		runtime.NewKVStoreService(appKeepers.keys[pfmroutertypes.StoreKey]),
+		tokenfactorybindings.CustomQuerier(tokenfactorybindings.NewQueryPlugin(appKeepers.BankKeeper, &appKeepers.TokenFactoryKeeper)),
+	)
+	wasmOpts = append(wasmOpts, gaiaBindingsOpts...)
 
 	// Add governance vote validation decorator for wasm contracts
 	// This prevents contracts from bypassing the stake requirement for voting
@@ -467,14 +558,16 @@ func NewAppKeeper(
 	)
 	wasmOpts = append(wasmOpts, govVoteDecorator)
 
-	// Allow contracts read-only access to validator info and governance proposal
-	// state via the wasm Grpc query plugin.
-	grpcAcceptList := wasmkeeper.AcceptedQueries{
-		"/cosmos.staking.v1beta1.Query/Validator": func() proto.Message { return &stakingtypes.QueryValidatorResponse{} },
-		"/cosmos.gov.v1.Query/Proposal":           func() proto.Message { return &govv1.QueryProposalResponse{} },
-	}
+	// Contracts may call the gRPC queries of the governance controlled
+	// x/wasmquery accept list via the wasm Grpc query plugin.
+	appKeepers.WasmQueryKeeper = wasmquerykeeper.NewKeeper(
+		appCodec,
+		runtime.NewKVStoreService(appKeepers.keys[wasmquerytypes.StoreKey]),
+		bApp.GRPCQueryRouter(),
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+	)
 	wasmOpts = append(wasmOpts, wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
-		Grpc: wasmkeeper.AcceptListGrpcQuerier(grpcAcceptList, bApp.GRPCQueryRouter(), appCodec),
+		Grpc: appKeepers.WasmQueryKeeper.GrpcQuerier(),
 	}))
 
 	appKeepers.WasmKeeper = wasmkeeper.NewKeeper(
@@ -484,10 +577,10 @@ func NewAppKeeper(
 		appKeepers.BankKeeper,
 		appKeepers.StakingKeeper,
 		distrkeeper.NewQuerier(appKeepers.DistrKeeper),
-		appKeepers.IBCKeeper.ChannelKeeper,
+		gaiaratelimit.NewICS20ICS4Wrapper(appKeepers.IBCKeeper.ChannelKeeper, appKeepers.RatelimitKeeper), // ICS4Wrapper
 		appKeepers.IBCKeeper.ChannelKeeper,
 		appKeepers.TransferKeeper,
-		bApp.MsgServiceRouter(),
+		policyMsgRouter,
 		bApp.GRPCQueryRouter(),
 		wasmDir,
 		wasmConfig,
@@ -504,12 +597,13 @@ func NewAppKeeper(
 	// Create Transfer Stack (from bottom to top of stack)
 	// - core IBC
 	// - ratelimit
+	// - pfmparams
 	// - pfm
 	// - callbacks
 	// - transfer
 	//
 	// This is how transfer stack will work in the end:
-	// * RecvPacket -> IBC core -> RateLimit -> PFM -> Callbacks -> Transfer (AddRoute)
+	// * RecvPacket -> IBC core -> RateLimit -> PFMParams -> PFM -> Callbacks -> Transfer (AddRoute)
 	// * SendPacket -> Transfer -> Callbacks -> PFM -> RateLimit -> IBC core (ICS4Wrapper)
 
 	var transferStack porttypes.IBCModule
@@ -519,14 +613,22 @@ func NewAppKeeper(
 	transferStack = pfmrouter.NewIBCMiddleware(
 		cbStack,
 		appKeepers.PFMRouterKeeper,
-		0, // retries on timeout
+		// the retries on timeout and the timeout are set in the memo of the
+		// forwarded packets by the pfmparams middleware
+		0,
 		pfmrouterkeeper.DefaultForwardTransferPacketTimeoutTimestamp,
 	)
+	transferStack = pfmparams.NewIBCMiddleware(transferStack, appKeepers.PFMParamsKeeper)
 	transferStack = ratelimit.NewIBCMiddleware(appKeepers.RatelimitKeeper, transferStack)
 	appKeepers.TransferKeeper.WithICS4Wrapper(cbStack)
 
 	// Create ICAHost Stack
 	var icaHostStack porttypes.IBCModule = icahost.NewIBCModule(appKeepers.ICAHostKeeper)
+	icaHostStack = ibccallbacks.NewIBCMiddleware(icaHostStack, appKeepers.IBCKeeper.ChannelKeeper,
+		wasmStackIBCHandler, gaiaparams.MaxIBCCallbackGas)
+	appKeepers.ICAHostKeeper.WithICS4Wrapper(icaHostStack.(porttypes.ICS4Wrapper))
+	icaHostStack = gaiaratelimit.NewICAHostMiddleware(icaHostStack, appKeepers.RatelimitKeeper,
+		appKeepers.ICAHostKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.TransferKeeper)
 
 	// Create Interchain Accounts Controller Stack
 	var icaControllerStack porttypes.IBCModule = icacontroller.NewIBCMiddleware(appKeepers.ICAControllerKeeper)
@@ -534,7 +636,8 @@ func NewAppKeeper(
 		wasmStackIBCHandler, gaiaparams.MaxIBCCallbackGas)
 	icaICS4Wrapper := icaControllerStack.(porttypes.ICS4Wrapper)
 	appKeepers.ICAControllerKeeper.WithICS4Wrapper(icaICS4Wrapper)
-	wasmStack := wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.ChannelKeeper)
+	var wasmStack porttypes.IBCModule = wasm.NewIBCHandler(appKeepers.WasmKeeper, appKeepers.IBCKeeper.ChannelKeeper, appKeepers.IBCKeeper.ChannelKeeper)
+	wasmStack = gaiaratelimit.NewICS20Middleware(wasmStack, appKeepers.RatelimitKeeper)
 
 	// Create IBCv2 Transfer Stack
 	var transferStackV2 ibcapi.IBCModule
@@ -548,18 +651,29 @@ func NewAppKeeper(
 		appKeepers.IBCKeeper.ChannelKeeperV2,
 	)
 
+	// Create IBCv2 ICAHost Stack
+	var icaHostStackV2 ibcapi.IBCModule
+	icaHostStackV2 = icahostv2.NewIBCModule(appCodec, appKeepers.ICAHostKeeper, appKeepers.AccountKeeper, policyMsgRouter)
+	icaHostStackV2 = ibccallbacksv2.NewIBCMiddleware(icaHostStackV2, appKeepers.IBCKeeper.ChannelKeeperV2,
+		wasmStackIBCHandler, appKeepers.IBCKeeper.ChannelKeeperV2, gaiaparams.MaxIBCCallbackGas)
+	icaHostStackV2 = gaiaratelimit.NewICAHostMiddlewareV2(icaHostStackV2, appKeepers.RatelimitKeeper,
+		appKeepers.TransferKeeper)
+
 	// Create IBC Router & seal
+	// the messages routed by each packet callback are counted from zero by the
+	// message policies
 	ibcRouter := porttypes.NewRouter().
-		AddRoute(icahosttypes.SubModuleName, icaHostStack).
-		AddRoute(icacontrollertypes.SubModuleName, icaControllerStack).
-		AddRoute(ibctransfertypes.ModuleName, transferStack).
-		AddRoute(wasmtypes.ModuleName, wasmStack)
+		AddRoute(icahosttypes.SubModuleName, ante.NewMsgPolicyIBCMiddleware(icaHostStack)).
+		AddRoute(icacontrollertypes.SubModuleName, ante.NewMsgPolicyIBCMiddleware(icaControllerStack)).
+		AddRoute(ibctransfertypes.ModuleName, ante.NewMsgPolicyIBCMiddleware(transferStack)).
+		AddRoute(wasmtypes.ModuleName, ante.NewMsgPolicyIBCMiddleware(wasmStack))
 
 	appKeepers.IBCKeeper.SetRouter(ibcRouter)
 
 	// Create IBCv2 Router & seal
 	ibcv2Router := ibcapi.NewRouter().
-		AddRoute(ibctransfertypes.PortID, transferStackV2)
+		AddRoute(ibctransfertypes.PortID, ante.NewMsgPolicyIBCMiddlewareV2(transferStackV2)).
+		AddRoute(icahosttypes.SubModuleName, ante.NewMsgPolicyIBCMiddlewareV2(icaHostStackV2))
 	appKeepers.IBCKeeper.SetRouterV2(ibcv2Router)
 
 	// Middleware Stacks
@@ -607,17 +721,3 @@ func initParamsKeeper(appCodec codec.BinaryCodec, legacyAmino *codec.LegacyAmino
 
 	return paramsKeeper
 }
-
-type DefaultFeemarketDenomResolver struct{}
-
-func (r *DefaultFeemarketDenomResolver) ConvertToDenom(_ sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
-	if coin.Denom == denom {
-		return coin, nil
-	}
-
-	return sdk.DecCoin{}, errors.New("error resolving denom")
-}
-
-func (r *DefaultFeemarketDenomResolver) ExtraDenoms(_ sdk.Context) ([]string, error) {
-	return []string{}, nil
-}
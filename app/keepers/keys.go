@@ -30,7 +30,14 @@ import (
 
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
+	gaiabanktypes "github.com/cosmos/gaia/v29/x/bank/types"
+	feedenomstypes "github.com/cosmos/gaia/v29/x/feedenoms/types"
 	liquidtypes "github.com/cosmos/gaia/v29/x/liquid/types"
+	metaprotocolstypes "github.com/cosmos/gaia/v29/x/metaprotocols/types"
+	msgpolicytypes "github.com/cosmos/gaia/v29/x/msgpolicy/types"
+	pfmparamstypes "github.com/cosmos/gaia/v29/x/pfmparams/types"
+	gaiatokenfactorytypes "github.com/cosmos/gaia/v29/x/tokenfactory/types"
+	wasmquerytypes "github.com/cosmos/gaia/v29/x/wasmquery/types"
 )
 
 func (appKeepers *AppKeepers) GenerateKeys() {
@@ -65,6 +72,13 @@ func (appKeepers *AppKeepers) GenerateKeys() {
 		ibcwasmtypes.StoreKey,
 		tokenfactorytypes.StoreKey,
 		liquidtypes.StoreKey,
+		msgpolicytypes.StoreKey,
+		gaiabanktypes.StoreKey,
+		metaprotocolstypes.StoreKey,
+		feedenomstypes.StoreKey,
+		wasmquerytypes.StoreKey,
+		pfmparamstypes.StoreKey,
+		gaiatokenfactorytypes.StoreKey,
 	)
 
 	// Define transient store keys
@@ -13,7 +13,6 @@ import (
 	ibc "github.com/cosmos/ibc-go/v10/modules/core"
 	ibcexported "github.com/cosmos/ibc-go/v10/modules/core/exported"
 	tendermint "github.com/cosmos/ibc-go/v10/modules/light-clients/07-tendermint"
-	"github.com/cosmos/tokenfactory/x/tokenfactory"
 	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
 
 	"cosmossdk.io/x/evidence"
@@ -58,11 +57,24 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	gaiabank "github.com/cosmos/gaia/v29/x/bank"
+	gaiabanktypes "github.com/cosmos/gaia/v29/x/bank/types"
+	"github.com/cosmos/gaia/v29/x/feedenoms"
+	feedenomstypes "github.com/cosmos/gaia/v29/x/feedenoms/types"
 	gaiagov "github.com/cosmos/gaia/v29/x/gov"
+	"github.com/cosmos/gaia/v29/x/legacy"
+	legacyics "github.com/cosmos/gaia/v29/x/legacy/ics"
 	"github.com/cosmos/gaia/v29/x/liquid"
 	liquidtypes "github.com/cosmos/gaia/v29/x/liquid/types"
 	"github.com/cosmos/gaia/v29/x/metaprotocols"
 	metaprotocolstypes "github.com/cosmos/gaia/v29/x/metaprotocols/types"
+	"github.com/cosmos/gaia/v29/x/msgpolicy"
+	msgpolicytypes "github.com/cosmos/gaia/v29/x/msgpolicy/types"
+	"github.com/cosmos/gaia/v29/x/pfmparams"
+	pfmparamstypes "github.com/cosmos/gaia/v29/x/pfmparams/types"
+	gaiatokenfactory "github.com/cosmos/gaia/v29/x/tokenfactory"
+	gaiatokenfactorytypes "github.com/cosmos/gaia/v29/x/tokenfactory/types"
+	"github.com/cosmos/gaia/v29/x/wasmquery"
+	wasmquerytypes "github.com/cosmos/gaia/v29/x/wasmquery/types"
 )
 
 var maccPerms = map[string][]string{
@@ -80,6 +92,12 @@ var maccPerms = map[string][]string{
 	tokenfactorytypes.ModuleName:    {authtypes.Minter, authtypes.Burner},
 }
 
+// legacyArchives holds the archived types of removed modules, which stay
+// decodable for queries of historical transactions and proposals.
+var legacyArchives = []*legacy.Archive{
+	legacyics.Archive,
+}
+
 func appModules(
 	app *GaiaApp,
 	appCodec codec.Codec,
@@ -95,7 +113,8 @@ func appModules(
 		),
 		auth.NewAppModule(appCodec, app.AccountKeeper, nil, app.GetSubspace(authtypes.ModuleName)),
 		vesting.NewAppModule(app.AccountKeeper, app.BankKeeper),
-		gaiabank.NewAppModule(appCodec, app.BankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
+		gaiabank.NewAppModule(appCodec, app.BankKeeper, app.GaiaBankKeeper, app.AccountKeeper, app.GetSubspace(banktypes.ModuleName)),
+		gaiabank.NewExtensionModule(app.GaiaBankKeeper),
 		gaiagov.NewAppModule(appCodec, app.GovKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(govtypes.ModuleName)),
 		mint.NewAppModule(appCodec, app.MintKeeper, app.AccountKeeper, nil, app.GetSubspace(minttypes.ModuleName)),
 		slashing.NewAppModule(appCodec, app.SlashingKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper, app.GetSubspace(slashingtypes.ModuleName), app.interfaceRegistry),
@@ -114,11 +133,17 @@ func appModules(
 		app.ICAModule,
 		app.PFMRouterModule,
 		app.RateLimitModule,
-		metaprotocols.NewAppModule(),
+		metaprotocols.NewAppModule(app.MetaprotocolsKeeper, app.metaprotocolsIndexer),
 		feemarket.NewAppModule(appCodec, *app.FeeMarketKeeper),
+		feedenoms.NewAppModule(app.FeeDenomsKeeper),
+		wasmquery.NewAppModule(app.WasmQueryKeeper),
+		pfmparams.NewAppModule(app.PFMParamsKeeper),
 		tendermint.NewAppModule(tmLightClientModule),
 		liquid.NewAppModule(appCodec, app.LiquidKeeper, app.AccountKeeper, app.BankKeeper, app.StakingKeeper),
-		tokenfactory.NewAppModule(app.TokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
+		msgpolicy.NewAppModule(app.MsgPolicyKeeper),
+		legacy.NewAppModule(legacyArchives...),
+		gaiatokenfactory.NewAppModule(app.TokenFactoryKeeper, app.GaiaTokenFactoryKeeper, app.AccountKeeper, app.BankKeeper, app.GetSubspace(tokenfactorytypes.ModuleName)),
+		gaiatokenfactory.NewExtensionModule(app.GaiaTokenFactoryKeeper),
 	}
 }
 
@@ -197,10 +222,16 @@ func orderBeginBlockers() []string {
 		paramstypes.ModuleName,
 		vestingtypes.ModuleName,
 		feemarkettypes.ModuleName,
+		feedenomstypes.ModuleName,
+		wasmquerytypes.ModuleName,
+		pfmparamstypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		metaprotocolstypes.ModuleName,
 		liquidtypes.ModuleName,
+		msgpolicytypes.ModuleName,
+		gaiabanktypes.ModuleName,
 		tokenfactorytypes.ModuleName,
+		gaiatokenfactorytypes.ModuleName,
 		wasmtypes.ModuleName,
 		ibcwasmtypes.ModuleName,
 	}
@@ -228,9 +259,15 @@ func orderEndBlockers() []string {
 		upgradetypes.ModuleName,
 		vestingtypes.ModuleName,
 		feemarkettypes.ModuleName,
+		feedenomstypes.ModuleName,
+		wasmquerytypes.ModuleName,
+		pfmparamstypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		metaprotocolstypes.ModuleName,
+		msgpolicytypes.ModuleName,
+		gaiabanktypes.ModuleName,
 		tokenfactorytypes.ModuleName,
+		gaiatokenfactorytypes.ModuleName,
 		wasmtypes.ModuleName,
 		ibcwasmtypes.ModuleName,
 	}
@@ -271,11 +308,17 @@ func orderInitBlockers() []string {
 		// A similar issue existed for the 'globalfee' module, which was previously used instead of 'feemarket'.
 		// For more details, please refer to the following link: https://github.com/cosmos/gaia/issues/2489
 		feemarkettypes.ModuleName,
+		feedenomstypes.ModuleName,
+		wasmquerytypes.ModuleName,
+		pfmparamstypes.ModuleName,
 		consensusparamtypes.ModuleName,
 		metaprotocolstypes.ModuleName,
 		wasmtypes.ModuleName,
 		ibcwasmtypes.ModuleName,
 		liquidtypes.ModuleName,
+		msgpolicytypes.ModuleName,
+		gaiabanktypes.ModuleName,
 		tokenfactorytypes.ModuleName,
+		gaiatokenfactorytypes.ModuleName,
 	}
 }
@@ -9,6 +9,7 @@ import (
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/cosmos/gaia/v29/ante"
+	feedenomspost "github.com/cosmos/gaia/v29/x/feedenoms/post"
 )
 
 // PostHandlerOptions are the options required for constructing a FeeMarket PostHandler.
@@ -16,9 +17,11 @@ type PostHandlerOptions struct {
 	AccountKeeper   feemarketpost.AccountKeeper
 	BankKeeper      feemarketpost.BankKeeper
 	FeeMarketKeeper feemarketpost.FeeMarketKeeper
+	FeeDenomsKeeper feedenomspost.FeeDenomsKeeper
 }
 
-// NewPostHandler returns a PostHandler chain with the fee deduct decorator.
+// NewPostHandler returns a PostHandler chain with the fee deduct decorator,
+// which refunds part of the fee paid for unused gas.
 func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
 	if !ante.UseFeeMarketDecorator {
 		return nil, nil
@@ -36,11 +39,16 @@ func NewPostHandler(options PostHandlerOptions) (sdk.PostHandler, error) {
 		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "feemarket keeper is required for post builder")
 	}
 
+	if options.FeeDenomsKeeper == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrLogic, "feedenoms keeper is required for post builder")
+	}
+
 	postDecorators := []sdk.PostDecorator{
-		feemarketpost.NewFeeMarketDeductDecorator(
+		feedenomspost.NewDeductFeeDecorator(
 			options.AccountKeeper,
 			options.BankKeeper,
 			options.FeeMarketKeeper,
+			options.FeeDenomsKeeper,
 		),
 	}
 
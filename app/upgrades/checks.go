@@ -0,0 +1,38 @@
+package upgrades
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/app/keepers"
+)
+
+// StoreEmpty returns a condition holding if the kv store storeKey is empty.
+func StoreEmpty(storeKey string) Condition {
+	return Condition{
+		Name: fmt.Sprintf("%s store empty", storeKey),
+		Check: func(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+			itr := ctx.KVStore(keepers.GetKey(storeKey)).Iterator(nil, nil)
+			defer itr.Close()
+			if itr.Valid() {
+				return fmt.Errorf("%s store has key %X", storeKey, itr.Key())
+			}
+			return nil
+		},
+	}
+}
+
+// TotalSupply is the invariant of the total supply of every denom.
+var TotalSupply = Invariant{
+	Name: "total supply",
+	Value: func(ctx sdk.Context, keepers *keepers.AppKeepers) (any, error) {
+		// the supply is iterated in denom order
+		var supply sdk.Coins
+		keepers.BankKeeper.IterateTotalSupply(ctx, func(coin sdk.Coin) bool {
+			supply = append(supply, coin)
+			return false
+		})
+		return supply, nil
+	},
+}
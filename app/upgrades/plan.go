@@ -0,0 +1,157 @@
+package upgrades
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	errorsmod "cosmossdk.io/errors"
+	store "cosmossdk.io/store/types"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	"github.com/cosmos/gaia/v29/app/keepers"
+)
+
+// Plan declares an upgrade: its store upgrades, the steps run before the
+// module migrations, the param changes run after them, and the conditions
+// checked around them.
+//
+// The upgrade handler of a plan runs in a cached context, which is only
+// written if every step succeeds and every condition holds. Otherwise the
+// handler returns an error naming the failed step or condition, and the node
+// halts at the upgrade height without any state change of the upgrade.
+type Plan struct {
+	// Upgrade version name, for the upgrade handler, e.g. `v7`
+	Name string
+
+	// Store upgrades, should be used for any new modules introduced, new modules deleted, or store names renamed.
+	StoreUpgrades store.StoreUpgrades
+
+	// PreConditions must hold before the upgrade.
+	PreConditions []Condition
+	// Invariants must have the same value before and after the upgrade.
+	Invariants []Invariant
+	// Steps are the state changes run before the module migrations.
+	Steps []Step
+	// ParamChanges are the param updates run after the module migrations,
+	// so that they can set the params of the modules added by the upgrade.
+	ParamChanges []Step
+	// PostConditions must hold after the upgrade.
+	PostConditions []Condition
+}
+
+// Step is a named state change of an upgrade.
+type Step struct {
+	Name string
+	Run  func(ctx sdk.Context, keepers *keepers.AppKeepers) error
+}
+
+// Condition is a named assertion on the state, which fails with the error
+// returned by Check.
+type Condition struct {
+	Name  string
+	Check func(ctx sdk.Context, keepers *keepers.AppKeepers) error
+}
+
+// Invariant is a named value of the state, e.g. the total supply, which an
+// upgrade must not change. Values are compared with reflect.DeepEqual.
+type Invariant struct {
+	Name  string
+	Value func(ctx sdk.Context, keepers *keepers.AppKeepers) (any, error)
+}
+
+// Upgrade returns the upgrade running the plan.
+func (p Plan) Upgrade() Upgrade {
+	return Upgrade{
+		UpgradeName:          p.Name,
+		CreateUpgradeHandler: p.CreateUpgradeHandler,
+		StoreUpgrades:        p.StoreUpgrades,
+	}
+}
+
+// CreateUpgradeHandler returns the upgrade handler running the plan.
+func (p Plan) CreateUpgradeHandler(
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+) upgradetypes.UpgradeHandler {
+	return func(c context.Context, _ upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
+		ctx := sdk.UnwrapSDKContext(c)
+		ctx.Logger().Info("Starting upgrade", "name", p.Name)
+
+		cacheCtx, write := ctx.CacheContext()
+		vm, err := p.run(cacheCtx, mm, configurator, keepers, vm)
+		if err != nil {
+			ctx.Logger().Error("Upgrade aborted", "name", p.Name, "error", err)
+			return vm, errorsmod.Wrapf(err, "upgrade %s", p.Name)
+		}
+		write()
+
+		ctx.Logger().Info("Upgrade complete", "name", p.Name)
+		return vm, nil
+	}
+}
+
+func (p Plan) run(
+	ctx sdk.Context,
+	mm *module.Manager,
+	configurator module.Configurator,
+	keepers *keepers.AppKeepers,
+	vm module.VersionMap,
+) (module.VersionMap, error) {
+	for _, condition := range p.PreConditions {
+		if err := condition.Check(ctx, keepers); err != nil {
+			return vm, errorsmod.Wrapf(err, "pre-condition %q", condition.Name)
+		}
+	}
+
+	values := make([]any, len(p.Invariants))
+	for i, invariant := range p.Invariants {
+		value, err := invariant.Value(ctx, keepers)
+		if err != nil {
+			return vm, errorsmod.Wrapf(err, "invariant %q", invariant.Name)
+		}
+		values[i] = value
+	}
+
+	for _, step := range p.Steps {
+		ctx.Logger().Info("Running upgrade step", "step", step.Name)
+		if err := step.Run(ctx, keepers); err != nil {
+			return vm, errorsmod.Wrapf(err, "step %q", step.Name)
+		}
+	}
+
+	ctx.Logger().Info("Starting module migrations...")
+	vm, err := mm.RunMigrations(ctx, configurator, vm)
+	if err != nil {
+		return vm, errorsmod.Wrapf(err, "running module migrations")
+	}
+
+	for _, change := range p.ParamChanges {
+		ctx.Logger().Info("Changing params", "change", change.Name)
+		if err := change.Run(ctx, keepers); err != nil {
+			return vm, errorsmod.Wrapf(err, "param change %q", change.Name)
+		}
+	}
+
+	for _, condition := range p.PostConditions {
+		if err := condition.Check(ctx, keepers); err != nil {
+			return vm, errorsmod.Wrapf(err, "post-condition %q", condition.Name)
+		}
+	}
+
+	for i, invariant := range p.Invariants {
+		value, err := invariant.Value(ctx, keepers)
+		if err != nil {
+			return vm, errorsmod.Wrapf(err, "invariant %q", invariant.Name)
+		}
+		if !reflect.DeepEqual(values[i], value) {
+			return vm, fmt.Errorf("invariant %q broken: %v before the upgrade, %v after", invariant.Name, values[i], value)
+		}
+	}
+
+	return vm, nil
+}
@@ -0,0 +1,158 @@
+package upgrades_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/app/keepers"
+	"github.com/cosmos/gaia/v29/app/upgrades"
+)
+
+var testKey = []byte("key")
+
+// setKey is a step setting testKey in the provider store.
+var setKey = upgrades.Step{
+	Name: "set key",
+	Run: func(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+		ctx.KVStore(keepers.GetKey("provider")).Set(testKey, []byte("value"))
+		return nil
+	},
+}
+
+// mint is a step minting a coin.
+var mint = upgrades.Step{
+	Name: "mint",
+	Run: func(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+		return keepers.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 1)))
+	},
+}
+
+func TestPlan(t *testing.T) {
+	failing := upgrades.Condition{
+		Name:  "failing",
+		Check: func(sdk.Context, *keepers.AppKeepers) error { return errors.New("failed") },
+	}
+
+	testCases := []struct {
+		name   string
+		plan   upgrades.Plan
+		expErr string
+	}{
+		{
+			name: "success",
+			plan: upgrades.Plan{
+				PreConditions: []upgrades.Condition{upgrades.StoreEmpty("provider")},
+				Invariants:    []upgrades.Invariant{upgrades.TotalSupply},
+				Steps:         []upgrades.Step{setKey},
+			},
+		},
+		{
+			name: "pre-condition fails",
+			plan: upgrades.Plan{
+				PreConditions: []upgrades.Condition{failing},
+				Steps:         []upgrades.Step{setKey},
+			},
+			expErr: `upgrade test: pre-condition "failing": failed`,
+		},
+		{
+			name: "step fails",
+			plan: upgrades.Plan{
+				Steps: []upgrades.Step{setKey, {
+					Name: "failing",
+					Run:  func(sdk.Context, *keepers.AppKeepers) error { return errors.New("failed") },
+				}},
+			},
+			expErr: `upgrade test: step "failing": failed`,
+		},
+		{
+			name: "post-condition fails",
+			plan: upgrades.Plan{
+				Steps:          []upgrades.Step{setKey},
+				PostConditions: []upgrades.Condition{upgrades.StoreEmpty("provider")},
+			},
+			expErr: `upgrade test: post-condition "provider store empty": provider store has key 6B6579`,
+		},
+		{
+			name: "invariant broken",
+			plan: upgrades.Plan{
+				Invariants:   []upgrades.Invariant{upgrades.TotalSupply},
+				Steps:        []upgrades.Step{setKey},
+				ParamChanges: []upgrades.Step{mint},
+			},
+			expErr: `upgrade test: invariant "total supply" broken`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gaiaApp := helpers.Setup(t)
+			ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+
+			tc.plan.Name = "test"
+			mm := module.NewManager()
+			configurator := module.NewConfigurator(gaiaApp.AppCodec(), gaiaApp.MsgServiceRouter(), gaiaApp.GRPCQueryRouter())
+			handler := tc.plan.Upgrade().CreateUpgradeHandler(mm, configurator, &gaiaApp.AppKeepers)
+			_, err := handler(ctx, upgradetypes.Plan{Name: "test", Height: 10}, module.VersionMap{})
+
+			// the steps of an aborted upgrade write nothing
+			value := ctx.KVStore(gaiaApp.GetKey("provider")).Get(testKey)
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				require.Nil(t, value)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, []byte("value"), value)
+		})
+	}
+}
+
+func TestPlanOrder(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+
+	var calls []string
+	condition := func(name string) upgrades.Condition {
+		return upgrades.Condition{Name: name, Check: func(sdk.Context, *keepers.AppKeepers) error {
+			calls = append(calls, name)
+			return nil
+		}}
+	}
+	step := func(name string) upgrades.Step {
+		return upgrades.Step{Name: name, Run: func(sdk.Context, *keepers.AppKeepers) error {
+			calls = append(calls, name)
+			return nil
+		}}
+	}
+	invariant := upgrades.Invariant{Name: "invariant", Value: func(sdk.Context, *keepers.AppKeepers) (any, error) {
+		calls = append(calls, "invariant")
+		return 0, nil
+	}}
+	plan := upgrades.Plan{
+		Name:           "test",
+		PreConditions:  []upgrades.Condition{condition("pre-condition")},
+		Invariants:     []upgrades.Invariant{invariant},
+		Steps:          []upgrades.Step{step("step 1"), step("step 2")},
+		ParamChanges:   []upgrades.Step{step("param change")},
+		PostConditions: []upgrades.Condition{condition("post-condition")},
+	}
+
+	configurator := module.NewConfigurator(gaiaApp.AppCodec(), gaiaApp.MsgServiceRouter(), gaiaApp.GRPCQueryRouter())
+	handler := plan.CreateUpgradeHandler(module.NewManager(), configurator, &gaiaApp.AppKeepers)
+	_, err := handler(ctx, upgradetypes.Plan{Name: "test", Height: 10}, module.VersionMap{})
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"pre-condition", "invariant", "step 1", "step 2", "param change", "post-condition", "invariant",
+	}, calls)
+}
@@ -30,6 +30,8 @@ type Upgrade struct {
 // There is one time code that can be added for the start of the Fork, in `BeginForkLogic`.
 // Any other change in the code should be height-gated, if the goal is to have old and new binaries
 // to be compatible prior to the upgrade height.
+// A fork must be added to the Forks of the app.go, which runs its BeginForkLogic
+// in the BeginBlocker of the block at its height.
 type Fork struct {
 	// Upgrade version name, for the upgrade handler, e.g. `v7`
 	UpgradeName string
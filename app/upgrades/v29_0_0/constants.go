@@ -1,7 +1,16 @@
 package v29_0_0
 
 import (
+	storetypes "cosmossdk.io/store/types"
+
 	"github.com/cosmos/gaia/v29/app/upgrades"
+	gaiabanktypes "github.com/cosmos/gaia/v29/x/bank/types"
+	feedenomstypes "github.com/cosmos/gaia/v29/x/feedenoms/types"
+	metaprotocolstypes "github.com/cosmos/gaia/v29/x/metaprotocols/types"
+	msgpolicytypes "github.com/cosmos/gaia/v29/x/msgpolicy/types"
+	pfmparamstypes "github.com/cosmos/gaia/v29/x/pfmparams/types"
+	gaiatokenfactorytypes "github.com/cosmos/gaia/v29/x/tokenfactory/types"
+	wasmquerytypes "github.com/cosmos/gaia/v29/x/wasmquery/types"
 )
 
 const (
@@ -9,7 +18,7 @@ const (
 	UpgradeName = "v29.0.0"
 
 	// providerStoreKey is the deprecated ICS "provider" kv-store. Its
-	// contents are wiped inside CreateUpgradeHandler (a plain KV iterate+
+	// contents are wiped by a step of the upgrade plan (a plain KV iterate+
 	// delete over ctx.KVStore) rather than via StoreUpgrades.Deleted:
 	// StoreUpgrades.Deleted only recognizes a deletion at the exact restart
 	// transitioning into the upgrade height, and permanently excludes the
@@ -23,7 +32,24 @@ const (
 	providerStoreKey = "provider"
 )
 
-var Upgrade = upgrades.Upgrade{
-	UpgradeName:          UpgradeName,
-	CreateUpgradeHandler: CreateUpgradeHandler,
+var Plan = upgrades.Plan{
+	Name: UpgradeName,
+	StoreUpgrades: storetypes.StoreUpgrades{
+		Added: []string{
+			msgpolicytypes.StoreKey,
+			gaiabanktypes.StoreKey,
+			metaprotocolstypes.StoreKey,
+			feedenomstypes.StoreKey,
+			wasmquerytypes.StoreKey,
+			pfmparamstypes.StoreKey,
+			gaiatokenfactorytypes.StoreKey,
+		},
+	},
+	Invariants: []upgrades.Invariant{upgrades.TotalSupply},
+	Steps: []upgrades.Step{
+		{Name: "delete provider store contents", Run: deleteProviderStoreContents},
+	},
+	PostConditions: []upgrades.Condition{upgrades.StoreEmpty(providerStoreKey)},
 }
+
+var Upgrade = Plan.Upgrade()
@@ -24,6 +24,7 @@ import (
 	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
 
 	gaiaapp "github.com/cosmos/gaia/v29/app"
+	"github.com/cosmos/gaia/v29/app/upgrades"
 	v290 "github.com/cosmos/gaia/v29/app/upgrades/v29_0_0"
 )
 
@@ -50,6 +51,21 @@ func newTestApp(homeDir string, db dbm.DB) *gaiaapp.GaiaApp {
 	)
 }
 
+// withoutAddedStores drops the stores added by the upgrades for the duration
+// of the test. Every test app runs the current binary, so those stores are
+// already mounted and committed before the upgrade height, unlike on a node
+// coming from the previous release.
+func withoutAddedStores(t *testing.T) {
+	t.Helper()
+	orig := gaiaapp.Upgrades
+	gaiaapp.Upgrades = make([]upgrades.Upgrade, len(orig))
+	copy(gaiaapp.Upgrades, orig)
+	for i := range gaiaapp.Upgrades {
+		gaiaapp.Upgrades[i].StoreUpgrades.Added = nil
+	}
+	t.Cleanup(func() { gaiaapp.Upgrades = orig })
+}
+
 // TestProviderStoreWipedByUpgradeHandler proves the content-deletion itself:
 // it calls v29_0_0.CreateUpgradeHandler directly (the same closure app.go
 // registers as the "v29.0.0" upgrade handler) against a real GaiaApp's
@@ -100,6 +116,7 @@ func TestProviderStoreWipedByUpgradeHandler(t *testing.T) {
 //     (an entirely ordinary restart). This would panic using the
 //     StoreUpgrades.Deleted mechanism.
 func TestProviderStoreSurvivesRestartAfterUpgrade(t *testing.T) {
+	withoutAddedStores(t)
 	db := dbm.NewMemDB()
 	testKey, testVal := []byte("leftover-ccv-key"), []byte("leftover-ccv-value")
 
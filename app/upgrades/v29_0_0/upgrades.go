@@ -1,9 +1,8 @@
 package v29_0_0
 
 import (
-	"context"
+	"fmt"
 
-	errorsmod "cosmossdk.io/errors"
 	upgradetypes "cosmossdk.io/x/upgrade/types"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -18,24 +17,7 @@ func CreateUpgradeHandler(
 	configurator module.Configurator,
 	keepers *keepers.AppKeepers,
 ) upgradetypes.UpgradeHandler {
-	return func(c context.Context, plan upgradetypes.Plan, vm module.VersionMap) (module.VersionMap, error) {
-		ctx := sdk.UnwrapSDKContext(c)
-		ctx.Logger().Info("Starting upgrade", "name", UpgradeName)
-
-		ctx.Logger().Info("Deleting contents of the deprecated provider kv-store...")
-		if err := deleteProviderStoreContents(ctx, keepers); err != nil {
-			return vm, errorsmod.Wrapf(err, "deleting provider store contents")
-		}
-
-		ctx.Logger().Info("Starting module migrations...")
-		vm, err := mm.RunMigrations(ctx, configurator, vm)
-		if err != nil {
-			return vm, errorsmod.Wrapf(err, "running module migrations")
-		}
-
-		ctx.Logger().Info("Upgrade complete", "name", UpgradeName)
-		return vm, nil
-	}
+	return Plan.CreateUpgradeHandler(mm, configurator, keepers)
 }
 
 // deleteProviderStoreContents empties every key/value in the deprecated ICS
@@ -59,6 +41,7 @@ func deleteProviderStoreContents(ctx sdk.Context, keepers *keepers.AppKeepers) e
 		store.Delete(key)
 	}
 
-	ctx.Logger().Info("Deleted provider store contents", "keys_deleted", len(keys))
+	ctx.Logger().Info("Deleted provider store contents", "keys_deleted", len(keys),
+		"export", fmt.Sprintf("gaiad debug export-provider-store --height %d", ctx.BlockHeight()-1))
 	return nil
 }
@@ -49,5 +49,7 @@ Example:
 // addDebugCommands injects custom debug commands into another command as children.
 func addDebugCommands(cmd *cobra.Command) *cobra.Command {
 	cmd.AddCommand(AddBech32ConvertCommand())
+	cmd.AddCommand(ExportProviderStoreCommand())
+	cmd.AddCommand(DryRunUpgradeCommand())
 	return cmd
 }
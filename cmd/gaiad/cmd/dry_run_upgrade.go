@@ -0,0 +1,225 @@
+package cmd
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/rs/zerolog"
+	"github.com/spf13/cobra"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/rootmulti"
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+	"github.com/cosmos/cosmos-sdk/types/module"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+
+	gaia "github.com/cosmos/gaia/v29/app"
+	"github.com/cosmos/gaia/v29/app/upgrades"
+)
+
+// DryRunUpgradeReport is the result of a dry run of an upgrade.
+type DryRunUpgradeReport struct {
+	Name    string `json:"name"`
+	Height  int64  `json:"height"`
+	Elapsed string `json:"elapsed"`
+	// ModuleVersions are the consensus versions of the modules migrated or
+	// added by the upgrade.
+	ModuleVersions map[string]ModuleVersionChange `json:"module_versions,omitempty"`
+	// KeysTouched is the number of keys set or deleted by the upgrade in
+	// each store.
+	KeysTouched map[string]int `json:"keys_touched,omitempty"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// ModuleVersionChange is the consensus version of a module before and after
+// an upgrade, 0 before for a module added by it.
+type ModuleVersionChange struct {
+	From uint64 `json:"from"`
+	To   uint64 `json:"to"`
+}
+
+// DryRunUpgradeCommand returns the command running an upgrade handler, with
+// its store upgrades, on the latest state of a data directory without
+// committing it.
+func DryRunUpgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "dry-run-upgrade [name]",
+		Short: "Run an upgrade against the latest state of a data directory without committing it",
+		Long: `Run an upgrade against the latest state of a data directory without committing it
+
+The application database of the node home directory is opened offline, so the
+node must be stopped. The store upgrades and the handler of the named upgrade
+are run at the height following the latest height of the database, including
+the pre- and post-conditions and the invariants of its plan. The migrated
+module versions, the elapsed time, the number of keys set or deleted in each
+store and any error are written as JSON. Nothing is committed, but store upgrades may leave data in the
+database, so run it against a copy of the data directory.
+
+Example:
+	cp -r ~/.gaia ~/.gaia-copy
+	gaiad debug dry-run-upgrade v29.0.0 --home ~/.gaia-copy
+	`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			name := args[0]
+			var upgrade *upgrades.Upgrade
+			for i := range gaia.Upgrades {
+				if gaia.Upgrades[i].UpgradeName == name {
+					upgrade = &gaia.Upgrades[i]
+				}
+			}
+			if upgrade == nil {
+				names := make([]string, len(gaia.Upgrades))
+				for i, u := range gaia.Upgrades {
+					names[i] = u.UpgradeName
+				}
+				return fmt.Errorf("unknown upgrade %q, expected one of: %s", name, strings.Join(names, ", "))
+			}
+
+			// a failed upgrade is reported, not a usage error
+			cmd.SilenceUsage = true
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			home := serverCtx.Config.RootDir
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(home, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			// logs go to stderr, so that stdout is the report
+			logger := log.NewLogger(cmd.ErrOrStderr(), log.LevelOption(zerolog.InfoLevel))
+			height := rootmulti.GetLatestVersion(db) + 1
+			gaiaApp := gaia.NewGaiaApp(logger, db, nil, false, map[int64]bool{}, home, serverCtx.Viper, []wasmkeeper.Option{})
+			storeUpgrades := upgrade.StoreUpgrades
+			gaiaApp.SetStoreLoader(upgradetypes.UpgradeStoreLoader(height, &storeUpgrades))
+			if err := gaiaApp.LoadLatestVersion(); err != nil {
+				return err
+			}
+
+			// the upgrade runs in a cache of a cache of the state, the first
+			// cache recording the keys written to it, and is never committed
+			recorder := &keyRecorder{}
+			cms := gaiaApp.CommitMultiStore()
+			cms.SetTracer(recorder)
+			upgradeStore := cms.CacheMultiStore().CacheMultiStore()
+			ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: height, Time: time.Now()}).WithMultiStore(upgradeStore)
+
+			doneHeight, err := gaiaApp.UpgradeKeeper.GetDoneHeight(ctx, name)
+			if err != nil {
+				return err
+			}
+			if doneHeight != 0 {
+				return fmt.Errorf("upgrade %s already applied at height %d", name, doneHeight)
+			}
+			fromVM, err := gaiaApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+			if err != nil {
+				return err
+			}
+
+			report := DryRunUpgradeReport{Name: name, Height: height}
+			start := time.Now()
+			upgradeErr := gaiaApp.UpgradeKeeper.ApplyUpgrade(ctx, upgradetypes.Plan{Name: name, Height: height})
+			report.Elapsed = time.Since(start).String()
+			if upgradeErr != nil {
+				report.Error = upgradeErr.Error()
+			} else {
+				toVM, err := gaiaApp.UpgradeKeeper.GetModuleVersionMap(ctx)
+				if err != nil {
+					return err
+				}
+				report.ModuleVersions = moduleVersionChanges(fromVM, toVM)
+
+				recorder.recording = true
+				upgradeStore.Write()
+				recorder.recording = false
+				if report.KeysTouched, err = recorder.keysTouched(); err != nil {
+					return err
+				}
+			}
+
+			bz, err := json.MarshalIndent(report, "", "  ")
+			if err != nil {
+				return err
+			}
+			if _, err := fmt.Fprintln(cmd.OutOrStdout(), string(bz)); err != nil {
+				return err
+			}
+			if upgradeErr != nil {
+				return fmt.Errorf("dry run of upgrade %s failed", name)
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+// moduleVersionChanges returns the modules whose version differs between
+// from and to.
+func moduleVersionChanges(from, to module.VersionMap) map[string]ModuleVersionChange {
+	changes := make(map[string]ModuleVersionChange)
+	for name, version := range to {
+		if from[name] != version {
+			changes[name] = ModuleVersionChange{From: from[name], To: version}
+		}
+	}
+	return changes
+}
+
+// keyRecorder is a store tracer recording the operations traced while
+// recording is set.
+type keyRecorder struct {
+	recording bool
+	trace     bytes.Buffer
+}
+
+func (r *keyRecorder) Write(p []byte) (int, error) {
+	if !r.recording {
+		return len(p), nil
+	}
+	return r.trace.Write(p)
+}
+
+// keysTouched returns the number of distinct keys set or deleted in each
+// store by the recorded operations.
+func (r *keyRecorder) keysTouched() (map[string]int, error) {
+	keys := make(map[string]map[string]bool)
+	decoder := json.NewDecoder(&r.trace)
+	for decoder.More() {
+		var op struct {
+			Operation string `json:"operation"`
+			Key       string `json:"key"`
+			Metadata  struct {
+				StoreName string `json:"store_name"`
+			} `json:"metadata"`
+		}
+		if err := decoder.Decode(&op); err != nil {
+			return nil, err
+		}
+		if op.Operation != "write" && op.Operation != "delete" {
+			continue
+		}
+		if keys[op.Metadata.StoreName] == nil {
+			keys[op.Metadata.StoreName] = make(map[string]bool)
+		}
+		keys[op.Metadata.StoreName][op.Key] = true
+	}
+
+	touched := make(map[string]int, len(keys))
+	for store, storeKeys := range keys {
+		touched[store] = len(storeKeys)
+	}
+	return touched, nil
+}
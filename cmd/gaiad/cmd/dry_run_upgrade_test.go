@@ -0,0 +1,101 @@
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	upgradetypes "cosmossdk.io/x/upgrade/types"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	app "github.com/cosmos/gaia/v29/app"
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/app/keepers"
+	"github.com/cosmos/gaia/v29/app/upgrades"
+	"github.com/cosmos/gaia/v29/cmd/gaiad/cmd"
+	"github.com/cosmos/gaia/v29/x/feedenoms"
+	feedenomstypes "github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+func TestDryRunUpgrade(t *testing.T) {
+	home := t.TempDir()
+
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	gaiaApp := helpers.SetupWithDB(t, db)
+	// feedenoms is added by the upgrades
+	upgradeStore := gaiaApp.CommitMultiStore().GetKVStore(gaiaApp.GetKey(upgradetypes.StoreKey))
+	upgradeStore.Delete(append([]byte{upgradetypes.VersionMapByte}, feedenomstypes.ModuleName...))
+	_, err = gaiaApp.Commit()
+	require.NoError(t, err)
+	height := gaiaApp.LastBlockHeight() + 1
+	require.NoError(t, db.Close())
+
+	setKey := upgrades.Step{
+		Name: "set key",
+		Run: func(ctx sdk.Context, keepers *keepers.AppKeepers) error {
+			ctx.KVStore(keepers.GetKey("provider")).Set([]byte("key"), []byte("value"))
+			return nil
+		},
+	}
+	orig := app.Upgrades
+	app.Upgrades = []upgrades.Upgrade{
+		upgrades.Plan{Name: "success", Steps: []upgrades.Step{setKey}}.Upgrade(),
+		upgrades.Plan{
+			Name:           "failure",
+			Steps:          []upgrades.Step{setKey},
+			PostConditions: []upgrades.Condition{upgrades.StoreEmpty("provider")},
+		}.Upgrade(),
+	}
+	t.Cleanup(func() { app.Upgrades = orig })
+
+	// every dry run is against a copy of home, as the wasm VMs of an app
+	// lock their directory for the lifetime of the process
+	dryRun := func(name string) (*bytes.Buffer, error) {
+		copyHome := t.TempDir()
+		require.NoError(t, os.CopyFS(copyHome, os.DirFS(home)))
+		var out bytes.Buffer
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetOut(&out)
+		rootCmd.SetArgs([]string{"debug", "dry-run-upgrade", name, "--home", copyHome})
+		return &out, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome)
+	}
+
+	out, err := dryRun("success")
+	require.NoError(t, err)
+	var report cmd.DryRunUpgradeReport
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	require.Equal(t, "success", report.Name)
+	require.Equal(t, height, report.Height)
+	require.NotEmpty(t, report.Elapsed)
+	require.Equal(t, map[string]cmd.ModuleVersionChange{
+		feedenomstypes.ModuleName: {From: 0, To: feedenoms.AppModule{}.ConsensusVersion()},
+	}, report.ModuleVersions)
+	require.Equal(t, 1, report.KeysTouched["provider"])
+	// the module version map, protocol version and done upgrade
+	require.Positive(t, report.KeysTouched[upgradetypes.StoreKey])
+	require.Empty(t, report.Error)
+
+	out, err = dryRun("failure")
+	require.EqualError(t, err, "dry run of upgrade failure failed")
+	report = cmd.DryRunUpgradeReport{}
+	require.NoError(t, json.Unmarshal(out.Bytes(), &report))
+	require.NotEmpty(t, report.Elapsed)
+	require.Equal(t, cmd.DryRunUpgradeReport{
+		Name:    "failure",
+		Height:  height,
+		Elapsed: report.Elapsed,
+		Error:   `upgrade failure: post-condition "provider store empty": provider store has key 6B6579`,
+	}, report)
+
+	_, err = dryRun("unknown")
+	require.EqualError(t, err, `unknown upgrade "unknown", expected one of: success, failure`)
+}
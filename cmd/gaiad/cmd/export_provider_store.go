@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"bufio"
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+
+	"github.com/spf13/cobra"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/server"
+
+	legacyics "github.com/cosmos/gaia/v29/x/legacy/ics"
+)
+
+const flagHeight = "height"
+
+// ExportProviderStoreCommand returns the command exporting the contents of
+// the ICS provider store, deleted by the v29 upgrade, from a pre-upgrade data
+// directory.
+func ExportProviderStoreCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-provider-store",
+		Short: "Export the ICS provider store of a pre-v29 data directory as JSON",
+		Long: `Export the ICS provider store of a pre-v29 data directory as JSON
+
+The application database of the node home directory is opened offline, so the
+node must be stopped. The height must not be pruned; it defaults to the latest
+height of the database. Consumer chain records, key assignments, opt-in lists
+and validator sets are decoded, other entries are exported raw.
+
+Example:
+	gaiad debug export-provider-store --home ~/.gaia-pre-v29 --height 24000000 > provider.json
+	`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			height, err := cmd.Flags().GetInt64(flagHeight)
+			if err != nil {
+				return err
+			}
+			if height < 0 {
+				return fmt.Errorf("invalid height %d", height)
+			}
+
+			serverCtx := server.GetServerContextFromCmd(cmd)
+			db, err := dbm.NewDB("application", server.GetAppDBBackend(serverCtx.Viper), filepath.Join(serverCtx.Config.RootDir, "data"))
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+			key := storetypes.NewKVStoreKey(legacyics.ProviderStoreKey)
+			ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+			if err := ms.LoadLatestVersion(); err != nil {
+				return err
+			}
+			if height == 0 {
+				height = ms.LastCommitID().Version
+			}
+			cms, err := ms.CacheMultiStoreWithVersion(height)
+			if err != nil {
+				return fmt.Errorf("failed to load height %d: %w", height, err)
+			}
+
+			return writeProviderStore(cmd, height, cms.GetKVStore(key))
+		},
+	}
+
+	cmd.Flags().Int64(flagHeight, 0, "Height of the exported store, the latest height if 0")
+
+	return cmd
+}
+
+// writeProviderStore writes the entries of the provider store one by one, so
+// that large stores are not held in memory.
+func writeProviderStore(cmd *cobra.Command, height int64, store storetypes.KVStore) error {
+	w := bufio.NewWriter(cmd.OutOrStdout())
+	if _, err := fmt.Fprintf(w, `{"height":%d,"entries":[`, height); err != nil {
+		return err
+	}
+	first := true
+	err := legacyics.IterateProviderStore(store, func(entry legacyics.ProviderStoreEntry) error {
+		if !first {
+			if err := w.WriteByte(','); err != nil {
+				return err
+			}
+		}
+		first = false
+		bz, err := json.Marshal(entry)
+		if err != nil {
+			return err
+		}
+		_, err = w.Write(bz)
+		return err
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := w.WriteString("]}\n"); err != nil {
+		return err
+	}
+	return w.Flush()
+}
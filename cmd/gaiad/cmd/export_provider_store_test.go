@@ -0,0 +1,64 @@
+package cmd_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store/metrics"
+	"cosmossdk.io/store/rootmulti"
+	storetypes "cosmossdk.io/store/types"
+
+	svrcmd "github.com/cosmos/cosmos-sdk/server/cmd"
+
+	app "github.com/cosmos/gaia/v29/app"
+	"github.com/cosmos/gaia/v29/cmd/gaiad/cmd"
+	legacyics "github.com/cosmos/gaia/v29/x/legacy/ics"
+)
+
+func TestExportProviderStore(t *testing.T) {
+	home := t.TempDir()
+
+	// commit a chain id at height 1, and delete it at height 2
+	db, err := dbm.NewDB("application", dbm.GoLevelDBBackend, filepath.Join(home, "data"))
+	require.NoError(t, err)
+	ms := rootmulti.NewStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	key := storetypes.NewKVStoreKey(legacyics.ProviderStoreKey)
+	ms.MountStoreWithDB(key, storetypes.StoreTypeIAVL, nil)
+	require.NoError(t, ms.LoadLatestVersion())
+	chainIDKey := append([]byte{0x2c, 0, 0, 0, 0, 0, 0, 0, 1}, '0')
+	ms.GetKVStore(key).Set(chainIDKey, []byte("neutron-1"))
+	ms.Commit()
+	ms.GetKVStore(key).Delete(chainIDKey)
+	ms.Commit()
+	require.NoError(t, db.Close())
+
+	export := func(args ...string) string {
+		var out bytes.Buffer
+		rootCmd := cmd.NewRootCmd()
+		rootCmd.SetOut(&out)
+		rootCmd.SetArgs(append([]string{"debug", "export-provider-store", "--home", home}, args...))
+		require.NoError(t, svrcmd.Execute(rootCmd, "", app.DefaultNodeHome))
+		return out.String()
+	}
+
+	var exported struct {
+		Height  int64                          `json:"height"`
+		Entries []legacyics.ProviderStoreEntry `json:"entries"`
+	}
+	require.NoError(t, json.Unmarshal([]byte(export("--height", "1")), &exported))
+	require.Equal(t, int64(1), exported.Height)
+	require.Equal(t, []legacyics.ProviderStoreEntry{{
+		Prefix: "ConsumerIdToChainId",
+		Key:    map[string]string{"consumer_id": "0"},
+		Value:  json.RawMessage(`"neutron-1"`),
+	}}, exported.Entries)
+
+	require.JSONEq(t, `{"height":2,"entries":[]}`, export())
+}
@@ -57,6 +57,10 @@ import (
 	wasmtypes "github.com/CosmWasm/wasmd/x/wasm/types"
 
 	gaia "github.com/cosmos/gaia/v29/app"
+	"github.com/cosmos/gaia/v29/pkg/txoutcome"
+	feedenomscli "github.com/cosmos/gaia/v29/x/feedenoms/client/cli"
+	metaprotocolscli "github.com/cosmos/gaia/v29/x/metaprotocols/client/cli"
+	metaprotocolsindexer "github.com/cosmos/gaia/v29/x/metaprotocols/indexer"
 )
 
 // NewRootCmd creates a new root command for simd. It is called once in the
@@ -130,6 +134,16 @@ func NewRootCmd() *cobra.Command {
 				initClientCtx = initClientCtx.WithTxConfig(txConfigWithTextual)
 			}
 
+			initClientCtx, err = metaprotocolscli.ReadExtensionFlags(initClientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			initClientCtx, err = feedenomscli.ReadAutoFeeFlag(initClientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+
 			if err = client.SetCmdClientContextHandler(initClientCtx, cmd); err != nil {
 				return err
 			}
@@ -182,11 +196,14 @@ func initAppConfig() (string, interface{}) {
 	srvCfg.StateSync.SnapshotKeepRecent = 10
 
 	customAppConfig := gaia.AppConfig{
-		Config: *srvCfg,
-		Wasm:   wasmtypes.DefaultNodeConfig(),
+		Config:        *srvCfg,
+		Wasm:          wasmtypes.DefaultNodeConfig(),
+		Metaprotocols: metaprotocolsindexer.DefaultConfig(),
+		TxOutcomes:    txoutcome.DefaultConfig(),
 	}
 
-	defaultAppTemplate := serverconfig.DefaultConfigTemplate + wasmtypes.DefaultConfigTemplate()
+	defaultAppTemplate := serverconfig.DefaultConfigTemplate + wasmtypes.DefaultConfigTemplate() +
+		metaprotocolsindexer.DefaultConfigTemplate() + txoutcome.DefaultConfigTemplate()
 
 	return defaultAppTemplate, customAppConfig
 }
@@ -291,6 +308,7 @@ func txCommand(basicManager module.BasicManager) *cobra.Command {
 	basicManager.AddTxCommands(cmd)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
+	metaprotocolscli.AddExtensionFlags(cmd)
 
 	return cmd
 }
@@ -0,0 +1,159 @@
+package txoutcome
+
+import (
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/spf13/cast"
+
+	"cosmossdk.io/log"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const (
+	// SinkPrometheus counts the outcomes by message type and error code.
+	SinkPrometheus = "prometheus"
+	// SinkJSONL writes the outcomes to a rotating JSON lines file.
+	SinkJSONL = "jsonl"
+
+	flagSinks          = "tx_outcomes.sinks"
+	flagJSONLFile      = "tx_outcomes.jsonl_file"
+	flagJSONLMaxSizeMB = "tx_outcomes.jsonl_max_size_mb"
+	flagJSONLMaxFiles  = "tx_outcomes.jsonl_max_files"
+)
+
+// Config is the node configuration of the tx outcome sinks, read from the
+// [tx_outcomes] section of app.toml.
+type Config struct {
+	// Sinks are the enabled sinks, SinkPrometheus and SinkJSONL.
+	Sinks []string `mapstructure:"sinks"`
+	// JSONLFile is the path of the JSON lines file, relative to the node home.
+	JSONLFile string `mapstructure:"jsonl_file"`
+	// JSONLMaxSizeMB is the size of the JSON lines file at which it is rotated.
+	JSONLMaxSizeMB int64 `mapstructure:"jsonl_max_size_mb"`
+	// JSONLMaxFiles is the number of rotated JSON lines files kept.
+	JSONLMaxFiles int `mapstructure:"jsonl_max_files"`
+}
+
+// DefaultConfig returns the default configuration, with no sink enabled.
+func DefaultConfig() Config {
+	return Config{
+		Sinks:          []string{},
+		JSONLFile:      filepath.Join("data", "tx_outcomes.jsonl"),
+		JSONLMaxSizeMB: 100,
+		JSONLMaxFiles:  5,
+	}
+}
+
+// DefaultConfigTemplate returns the app.toml snippet of the default configuration.
+func DefaultConfigTemplate() string {
+	return ConfigTemplate(DefaultConfig())
+}
+
+// ConfigTemplate returns the app.toml snippet of c.
+func ConfigTemplate(c Config) string {
+	sinks := make([]string, len(c.Sinks))
+	for i, sink := range c.Sinks {
+		sinks[i] = fmt.Sprintf("%q", sink)
+	}
+	return fmt.Sprintf(`
+[tx_outcomes]
+# Sinks recording the outcome of every transaction of the blocks processed by
+# the node: "prometheus" counts them by message type and error codespace and
+# code, with the gas used, and "jsonl" writes them to a rotating JSON lines
+# file. The outcomes are node-local and do not affect consensus state.
+sinks = [%s]
+# Path of the JSON lines file, relative to the node home directory.
+jsonl_file = %q
+# Size in MB at which the JSON lines file is rotated.
+jsonl_max_size_mb = %d
+# Number of rotated JSON lines files kept, the oldest are deleted.
+jsonl_max_files = %d
+`, strings.Join(sinks, ", "), c.JSONLFile, c.JSONLMaxSizeMB, c.JSONLMaxFiles)
+}
+
+// ReadConfig reads the sinks configuration from the app options.
+func ReadConfig(opts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	var err error
+	if v := opts.Get(flagSinks); v != nil {
+		if cfg.Sinks, err = cast.ToStringSliceE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagJSONLFile); v != nil {
+		if cfg.JSONLFile, err = cast.ToStringE(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagJSONLMaxSizeMB); v != nil {
+		if cfg.JSONLMaxSizeMB, err = cast.ToInt64E(v); err != nil {
+			return cfg, err
+		}
+	}
+	if v := opts.Get(flagJSONLMaxFiles); v != nil {
+		if cfg.JSONLMaxFiles, err = cast.ToIntE(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, cfg.Validate()
+}
+
+// Validate checks the sink names and the JSON lines file settings.
+func (c Config) Validate() error {
+	for _, sink := range c.Sinks {
+		switch sink {
+		case SinkPrometheus:
+		case SinkJSONL:
+			if c.JSONLFile == "" {
+				return fmt.Errorf("empty jsonl_file")
+			}
+			if c.JSONLMaxSizeMB <= 0 {
+				return fmt.Errorf("jsonl_max_size_mb must be positive, got %d", c.JSONLMaxSizeMB)
+			}
+			if c.JSONLMaxFiles < 0 {
+				return fmt.Errorf("jsonl_max_files must not be negative, got %d", c.JSONLMaxFiles)
+			}
+		default:
+			return fmt.Errorf("unknown tx outcome sink %q, expected %q or %q", sink, SinkPrometheus, SinkJSONL)
+		}
+	}
+	return nil
+}
+
+// NewSinks returns the sinks enabled by c. The JSON lines file is relative
+// to homePath, and the Prometheus metrics are registered in reg.
+func NewSinks(c Config, homePath string, reg prometheus.Registerer, logger log.Logger) ([]Sink, error) {
+	sinks := make([]Sink, 0, len(c.Sinks))
+	for _, name := range c.Sinks {
+		var (
+			sink Sink
+			err  error
+		)
+		switch name {
+		case SinkPrometheus:
+			sink, err = NewPrometheusSink(reg)
+		case SinkJSONL:
+			path := c.JSONLFile
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(homePath, path)
+			}
+			sink, err = NewJSONLSink(path, c.JSONLMaxSizeMB<<20, c.JSONLMaxFiles)
+		default:
+			err = fmt.Errorf("unknown tx outcome sink %q", name)
+		}
+		if err != nil {
+			for _, sink := range sinks {
+				if closeErr := sink.Close(); closeErr != nil {
+					logger.Error("failed to close tx outcome sink", "err", closeErr)
+				}
+			}
+			return nil, err
+		}
+		sinks = append(sinks, sink)
+	}
+	return sinks, nil
+}
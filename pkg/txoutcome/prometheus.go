@@ -0,0 +1,81 @@
+package txoutcome
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+// unknownMsgType is the message type label of transactions which could not
+// be decoded.
+const unknownMsgType = "unknown"
+
+// PrometheusSink counts the transaction outcomes, one per message, by message
+// type and error codespace and code, and observes the gas used by successful
+// and failed transactions.
+type PrometheusSink struct {
+	outcomes *prometheus.CounterVec
+	gasUsed  *prometheus.HistogramVec
+}
+
+// NewPrometheusSink registers the metrics of the sink in reg. Metrics already
+// registered, by another app of the same process, are shared.
+func NewPrometheusSink(reg prometheus.Registerer) (*PrometheusSink, error) {
+	outcomes := prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: "gaia",
+		Name:      "tx_outcomes_total",
+		Help:      "Number of messages of finalized transactions by message type and error codespace and code.",
+	}, []string{"msg_type", "codespace", "code"})
+	gasUsed := prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: "gaia",
+		Name:      "tx_gas_used",
+		Help:      "Gas used by finalized transactions, by status.",
+		Buckets:   prometheus.ExponentialBuckets(10_000, 2, 10),
+	}, []string{"status"})
+
+	var err error
+	if outcomes, err = register(reg, outcomes); err != nil {
+		return nil, err
+	}
+	if gasUsed, err = register(reg, gasUsed); err != nil {
+		return nil, err
+	}
+	return &PrometheusSink{outcomes: outcomes, gasUsed: gasUsed}, nil
+}
+
+// register registers c in reg, or returns the collector already registered.
+func register[C prometheus.Collector](reg prometheus.Registerer, c C) (C, error) {
+	err := reg.Register(c)
+	var alreadyRegistered prometheus.AlreadyRegisteredError
+	if errors.As(err, &alreadyRegistered) {
+		existing, ok := alreadyRegistered.ExistingCollector.(C)
+		if ok {
+			return existing, nil
+		}
+	}
+	return c, err
+}
+
+func (s *PrometheusSink) Record(outcome Outcome) error {
+	code := strconv.FormatUint(uint64(outcome.Code), 10)
+	msgTypes := outcome.MsgTypes
+	if len(msgTypes) == 0 {
+		msgTypes = []string{unknownMsgType}
+	}
+	for _, msgType := range msgTypes {
+		s.outcomes.WithLabelValues(msgType, outcome.Codespace, code).Inc()
+	}
+
+	status := "success"
+	if !outcome.Succeeded() {
+		status = "failure"
+	}
+	s.gasUsed.WithLabelValues(status).Observe(float64(outcome.GasUsed))
+	return nil
+}
+
+// Close is a no-op, the metrics stay registered.
+func (*PrometheusSink) Close() error {
+	return nil
+}
@@ -0,0 +1,137 @@
+package txoutcome
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Outcome is the outcome of a transaction of a finalized block.
+type Outcome struct {
+	Height  int64  `json:"height"`
+	TxIndex int    `json:"tx_index"`
+	TxHash  string `json:"tx_hash"`
+	// MsgTypes are the type URLs of the messages of the transaction, empty
+	// if it could not be decoded.
+	MsgTypes  []string `json:"msg_types"`
+	Codespace string   `json:"codespace,omitempty"`
+	Code      uint32   `json:"code"`
+	GasWanted int64    `json:"gas_wanted"`
+	GasUsed   int64    `json:"gas_used"`
+	// Log is the error of a failed transaction.
+	Log string `json:"log,omitempty"`
+}
+
+// Succeeded returns true if the transaction succeeded.
+func (o Outcome) Succeeded() bool {
+	return o.Code == abci.CodeTypeOK
+}
+
+// Sink records transaction outcomes.
+type Sink interface {
+	Record(outcome Outcome) error
+	Close() error
+}
+
+// Recorder builds the outcomes of transactions and records them in its sinks.
+type Recorder struct {
+	txDecoder sdk.TxDecoder
+	sinks     []Sink
+	logger    log.Logger
+}
+
+func NewRecorder(txDecoder sdk.TxDecoder, logger log.Logger, sinks ...Sink) *Recorder {
+	return &Recorder{
+		txDecoder: txDecoder,
+		sinks:     sinks,
+		logger:    logger.With("module", "txoutcome"),
+	}
+}
+
+// Record records the outcome of the transaction txBytes, at index txIndex of
+// the block of ctx, in every sink. Sink errors are logged, never returned,
+// so that a failing sink cannot stop the node.
+func (r *Recorder) Record(ctx sdk.Context, txIndex int, txBytes []byte, result *abci.ExecTxResult) {
+	outcome := Outcome{
+		Height:    ctx.BlockHeight(),
+		TxIndex:   txIndex,
+		TxHash:    fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash()),
+		MsgTypes:  []string{},
+		Codespace: result.Codespace,
+		Code:      result.Code,
+		GasWanted: result.GasWanted,
+		GasUsed:   result.GasUsed,
+	}
+	if !outcome.Succeeded() {
+		outcome.Log = result.Log
+	}
+	if tx, err := r.txDecoder(txBytes); err == nil {
+		for _, msg := range tx.GetMsgs() {
+			outcome.MsgTypes = append(outcome.MsgTypes, sdk.MsgTypeURL(msg))
+		}
+	}
+
+	for _, sink := range r.sinks {
+		if err := sink.Record(outcome); err != nil {
+			r.logger.Error("failed to record tx outcome", "height", outcome.Height, "tx", txIndex, "err", err)
+		}
+	}
+}
+
+// Close closes the sinks.
+func (r *Recorder) Close() error {
+	var err error
+	for _, sink := range r.sinks {
+		err = errors.Join(err, sink.Close())
+	}
+	return err
+}
+
+// Hooks are called with the result of every transaction of a finalized block.
+type Hooks interface {
+	OnTxSucceeded(ctx sdk.Context, txIndex int, txBytes []byte, result *abci.ExecTxResult)
+	OnTxFailed(ctx sdk.Context, txIndex int, txBytes []byte, result *abci.ExecTxResult)
+}
+
+var _ storetypes.ABCIListener = Listener{}
+
+// Listener is an ABCIListener calling its hooks with the result of every
+// transaction of a finalized block.
+type Listener struct {
+	hooks Hooks
+}
+
+func NewListener(hooks Hooks) Listener {
+	return Listener{hooks: hooks}
+}
+
+// ListenFinalizeBlock calls OnTxSucceeded or OnTxFailed for every transaction
+// of the block.
+func (l Listener) ListenFinalizeBlock(c context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	ctx := sdk.UnwrapSDKContext(c)
+	for i, txBytes := range req.Txs {
+		if i >= len(res.TxResults) {
+			break
+		}
+		result := res.TxResults[i]
+		if result.Code == abci.CodeTypeOK {
+			l.hooks.OnTxSucceeded(ctx, i, txBytes, result)
+		} else {
+			l.hooks.OnTxFailed(ctx, i, txBytes, result)
+		}
+	}
+	return nil
+}
+
+// ListenCommit is a no-op, outcomes are recorded when the block is finalized.
+func (Listener) ListenCommit(context.Context, abci.ResponseCommit, []*storetypes.StoreKVPair) error {
+	return nil
+}
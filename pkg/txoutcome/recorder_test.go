@@ -0,0 +1,104 @@
+package txoutcome_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	"cosmossdk.io/log"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/pkg/txoutcome"
+)
+
+// memSink is a sink keeping the outcomes in memory.
+type memSink struct {
+	outcomes []txoutcome.Outcome
+}
+
+func (s *memSink) Record(outcome txoutcome.Outcome) error {
+	s.outcomes = append(s.outcomes, outcome)
+	return nil
+}
+
+func (*memSink) Close() error { return nil }
+
+// hooks record the outcomes of OnTxSucceeded and OnTxFailed in separate
+// recorders.
+type hooks struct {
+	succeeded, failed *txoutcome.Recorder
+}
+
+func (h hooks) OnTxSucceeded(ctx sdk.Context, txIndex int, txBytes []byte, result *abci.ExecTxResult) {
+	h.succeeded.Record(ctx, txIndex, txBytes, result)
+}
+
+func (h hooks) OnTxFailed(ctx sdk.Context, txIndex int, txBytes []byte, result *abci.ExecTxResult) {
+	h.failed.Record(ctx, txIndex, txBytes, result)
+}
+
+func TestListener(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	txConfig := gaiaApp.GetTxConfig()
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	txBuilder := txConfig.NewTxBuilder()
+	require.NoError(t, txBuilder.SetMsgs(
+		banktypes.NewMsgSend(alice, alice, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1))),
+		&banktypes.MsgMultiSend{},
+	))
+	txBytes, err := txConfig.TxEncoder()(txBuilder.GetTx())
+	require.NoError(t, err)
+
+	succeeded, failed := &memSink{}, &memSink{}
+	listener := txoutcome.NewListener(hooks{
+		succeeded: txoutcome.NewRecorder(txConfig.TxDecoder(), log.NewNopLogger(), succeeded),
+		failed:    txoutcome.NewRecorder(txConfig.TxDecoder(), log.NewNopLogger(), failed),
+	})
+	err = listener.ListenFinalizeBlock(ctx,
+		abci.RequestFinalizeBlock{Height: 10, Txs: [][]byte{txBytes, txBytes, []byte("invalid")}},
+		abci.ResponseFinalizeBlock{TxResults: []*abci.ExecTxResult{
+			{GasWanted: 200_000, GasUsed: 80_000, Log: "ignored"},
+			{Codespace: "gaiabank", Code: 5, GasWanted: 200_000, GasUsed: 60_000, Log: "too many recipients"},
+			{Codespace: "sdk", Code: 2, Log: "tx parse error"},
+		}})
+	require.NoError(t, err)
+
+	msgTypes := []string{"/cosmos.bank.v1beta1.MsgSend", "/cosmos.bank.v1beta1.MsgMultiSend"}
+	txHash := fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+	require.Equal(t, []txoutcome.Outcome{{
+		Height:    10,
+		TxHash:    txHash,
+		MsgTypes:  msgTypes,
+		GasWanted: 200_000,
+		GasUsed:   80_000,
+	}}, succeeded.outcomes)
+	require.Equal(t, []txoutcome.Outcome{{
+		Height:    10,
+		TxIndex:   1,
+		TxHash:    txHash,
+		MsgTypes:  msgTypes,
+		Codespace: "gaiabank",
+		Code:      5,
+		GasWanted: 200_000,
+		GasUsed:   60_000,
+		Log:       "too many recipients",
+	}, {
+		Height:    10,
+		TxIndex:   2,
+		TxHash:    fmt.Sprintf("%X", cmttypes.Tx("invalid").Hash()),
+		MsgTypes:  []string{},
+		Codespace: "sdk",
+		Code:      2,
+		Log:       "tx parse error",
+	}}, failed.outcomes)
+}
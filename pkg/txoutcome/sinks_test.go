@@ -0,0 +1,161 @@
+package txoutcome_test
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/log"
+
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+
+	"github.com/cosmos/gaia/v29/pkg/txoutcome"
+)
+
+var (
+	sendOutcome = txoutcome.Outcome{
+		Height:   1,
+		TxHash:   "AA",
+		MsgTypes: []string{"/cosmos.bank.v1beta1.MsgSend"},
+		GasUsed:  50_000,
+	}
+	voteOutcome = txoutcome.Outcome{
+		Height:    1,
+		TxIndex:   1,
+		TxHash:    "BB",
+		MsgTypes:  []string{"/cosmos.gov.v1.MsgVote", "/cosmos.gov.v1beta1.MsgVote"},
+		Codespace: "gaia",
+		Code:      7,
+		GasUsed:   30_000,
+		Log:       "insufficient stake",
+	}
+)
+
+// readOutcomes returns the outcomes of the JSON lines file path.
+func readOutcomes(t *testing.T, path string) []txoutcome.Outcome {
+	t.Helper()
+
+	bz, err := os.ReadFile(path)
+	require.NoError(t, err)
+	var outcomes []txoutcome.Outcome
+	for _, line := range strings.Split(strings.TrimSuffix(string(bz), "\n"), "\n") {
+		var outcome txoutcome.Outcome
+		require.NoError(t, json.Unmarshal([]byte(line), &outcome))
+		outcomes = append(outcomes, outcome)
+	}
+	return outcomes
+}
+
+func TestJSONLSink(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "data", "outcomes.jsonl")
+	line, err := json.Marshal(sendOutcome)
+	require.NoError(t, err)
+
+	// two outcomes fit in a file
+	sink, err := txoutcome.NewJSONLSink(path, int64(2*(len(line)+1)), 2)
+	require.NoError(t, err)
+	for i := range 7 {
+		outcome := sendOutcome
+		outcome.Height = int64(i + 1)
+		require.NoError(t, sink.Record(outcome))
+	}
+	require.NoError(t, sink.Close())
+
+	heights := func(path string) []int64 {
+		var heights []int64
+		for _, outcome := range readOutcomes(t, path) {
+			heights = append(heights, outcome.Height)
+		}
+		return heights
+	}
+	require.Equal(t, []int64{7}, heights(path))
+	require.Equal(t, []int64{5, 6}, heights(path+".1"))
+	require.Equal(t, []int64{3, 4}, heights(path+".2"))
+	require.NoFileExists(t, path+".3")
+
+	// a reopened sink appends to the file
+	sink, err = txoutcome.NewJSONLSink(path, int64(2*(len(line)+1)), 2)
+	require.NoError(t, err)
+	outcome := sendOutcome
+	outcome.Height = 8
+	require.NoError(t, sink.Record(outcome))
+	require.NoError(t, sink.Close())
+	require.Equal(t, []int64{7, 8}, heights(path))
+}
+
+func TestJSONLSinkNoRotatedFiles(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "outcomes.jsonl")
+	sink, err := txoutcome.NewJSONLSink(path, 1, 0)
+	require.NoError(t, err)
+	require.NoError(t, sink.Record(sendOutcome))
+	require.NoError(t, sink.Record(voteOutcome))
+	require.NoError(t, sink.Close())
+
+	require.Equal(t, []txoutcome.Outcome{voteOutcome}, readOutcomes(t, path))
+	require.NoFileExists(t, path+".1")
+}
+
+func TestPrometheusSink(t *testing.T) {
+	reg := prometheus.NewRegistry()
+	sink, err := txoutcome.NewPrometheusSink(reg)
+	require.NoError(t, err)
+	// a second sink shares the registered metrics
+	other, err := txoutcome.NewPrometheusSink(reg)
+	require.NoError(t, err)
+
+	require.NoError(t, sink.Record(sendOutcome))
+	require.NoError(t, other.Record(sendOutcome))
+	require.NoError(t, sink.Record(voteOutcome))
+	require.NoError(t, sink.Record(txoutcome.Outcome{Codespace: "sdk", Code: 2}))
+
+	expected := `
+# HELP gaia_tx_outcomes_total Number of messages of finalized transactions by message type and error codespace and code.
+# TYPE gaia_tx_outcomes_total counter
+gaia_tx_outcomes_total{code="0",codespace="",msg_type="/cosmos.bank.v1beta1.MsgSend"} 2
+gaia_tx_outcomes_total{code="2",codespace="sdk",msg_type="unknown"} 1
+gaia_tx_outcomes_total{code="7",codespace="gaia",msg_type="/cosmos.gov.v1.MsgVote"} 1
+gaia_tx_outcomes_total{code="7",codespace="gaia",msg_type="/cosmos.gov.v1beta1.MsgVote"} 1
+`
+	require.NoError(t, testutil.GatherAndCompare(reg, strings.NewReader(expected), "gaia_tx_outcomes_total"))
+	require.Equal(t, 2, testutil.CollectAndCount(reg, "gaia_tx_gas_used"))
+}
+
+func TestReadConfig(t *testing.T) {
+	home := t.TempDir()
+
+	cfg, err := txoutcome.ReadConfig(simtestutil.AppOptionsMap{})
+	require.NoError(t, err)
+	require.Equal(t, txoutcome.DefaultConfig(), cfg)
+	sinks, err := txoutcome.NewSinks(cfg, home, prometheus.NewRegistry(), log.NewNopLogger())
+	require.NoError(t, err)
+	require.Empty(t, sinks)
+
+	cfg, err = txoutcome.ReadConfig(simtestutil.AppOptionsMap{
+		"tx_outcomes.sinks":             []any{"prometheus", "jsonl"},
+		"tx_outcomes.jsonl_max_size_mb": 10,
+	})
+	require.NoError(t, err)
+	require.Equal(t, []string{txoutcome.SinkPrometheus, txoutcome.SinkJSONL}, cfg.Sinks)
+	require.Equal(t, int64(10), cfg.JSONLMaxSizeMB)
+	sinks, err = txoutcome.NewSinks(cfg, home, prometheus.NewRegistry(), log.NewNopLogger())
+	require.NoError(t, err)
+	require.Len(t, sinks, 2)
+	require.FileExists(t, filepath.Join(home, "data", "tx_outcomes.jsonl"))
+	for _, sink := range sinks {
+		require.NoError(t, sink.Close())
+	}
+
+	_, err = txoutcome.ReadConfig(simtestutil.AppOptionsMap{"tx_outcomes.sinks": []any{"kafka"}})
+	require.EqualError(t, err, `unknown tx outcome sink "kafka", expected "prometheus" or "jsonl"`)
+	_, err = txoutcome.ReadConfig(simtestutil.AppOptionsMap{
+		"tx_outcomes.sinks":             []any{"jsonl"},
+		"tx_outcomes.jsonl_max_size_mb": 0,
+	})
+	require.EqualError(t, err, "jsonl_max_size_mb must be positive, got 0")
+}
@@ -0,0 +1,52 @@
+package bank
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/gaia/v29/x/bank/types"
+)
+
+func (am ExtensionModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the MultiSend and BatchSend limits",
+					Example:   fmt.Sprintf("$ %s query gaiabank params", version.AppName),
+				},
+				{
+					RpcMethod: "FanoutBudget",
+					Use:       "fanout-budget [address]",
+					Short:     "Query the fan-out an account may still send in the current window",
+					Example: fmt.Sprintf("$ %s query gaiabank fanout-budget %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj",
+						version.AppName, sdk.GetConfig().GetBech32AccountAddrPrefix()),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "address"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "BatchSend",
+					Skip:      true, // provided by the custom batch-send command
+				},
+			},
+			EnhanceCustomCommand: true,
+		},
+	}
+}
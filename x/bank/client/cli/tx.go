@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/gaia/v29/x/bank/types"
+)
+
+// NewTxCmd returns a root CLI command handler for all x/gaiabank transaction commands.
+func NewTxCmd() *cobra.Command {
+	bankTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Gaia bank transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	bankTxCmd.AddCommand(
+		NewBatchSendCmd(),
+	)
+
+	return bankTxCmd
+}
+
+// NewBatchSendCmd defines a command for sending coins to many recipients,
+// each transfer carrying its own reference ID.
+func NewBatchSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-send [transfers-file]",
+		Short: "Send coins to many recipients with a reference ID per transfer",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Send coins from the signer to every recipient listed in a JSON file.
+
+Example:
+$ %s tx gaiabank batch-send transfers.json --from mykey
+
+Where transfers.json contains:
+{
+  "transfers": [
+    {"recipient": "cosmos1...", "amount": [{"denom": "uatom", "amount": "100"}], "reference_id": "payout-1"},
+    {"recipient": "cosmos1...", "amount": [{"denom": "uatom", "amount": "250"}], "reference_id": "payout-2"}
+  ]
+}
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgBatchSend{}
+			if err := clientCtx.Codec.UnmarshalJSON(bz, msg); err != nil {
+				return fmt.Errorf("failed to parse transfers file: %w", err)
+			}
+			msg.Sender = clientCtx.GetFromAddress().String()
+
+			for i, transfer := range msg.Transfers {
+				if err := transfer.Validate(); err != nil {
+					return fmt.Errorf("transfer %d: %w", i, err)
+				}
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
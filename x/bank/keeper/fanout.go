@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/bank/types"
+)
+
+// GetFanoutUsage returns the fan-out used by addr in window.
+func (k Keeper) GetFanoutUsage(ctx context.Context, window uint64, addr sdk.AccAddress) (usage types.FanoutUsage, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetFanoutUsageKey(window, addr))
+	if err != nil || bz == nil {
+		return usage, err
+	}
+
+	err = k.cdc.Unmarshal(bz, &usage)
+	return usage, err
+}
+
+// SetFanoutUsage stores the fan-out used by addr in usage.Window.
+func (k Keeper) SetFanoutUsage(ctx context.Context, addr sdk.AccAddress, usage types.FanoutUsage) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&usage)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.GetFanoutUsageKey(usage.Window, addr), bz)
+}
+
+// PruneFanoutUsages deletes the fan-out used in the windows before the
+// current one, or all of it if the fan-out budget is disabled.
+func (k Keeper) PruneFanoutUsages(ctx context.Context) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	end := storetypes.PrefixEndBytes(types.FanoutUsagePrefix)
+	if params.FanoutBudgetEnabled() {
+		end = types.GetFanoutWindowKey(params.FanoutWindow(sdk.UnwrapSDKContext(ctx).BlockHeight()))
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.FanoutUsagePrefix, end)
+	if err != nil {
+		return err
+	}
+	var keys [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	if err := iterator.Close(); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := store.Delete(key); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// GetRemainingFanout returns the fan-out addr may still send in the current
+// window. It returns false if the fan-out budget is disabled.
+func (k Keeper) GetRemainingFanout(ctx context.Context, params types.Params, addr sdk.AccAddress) (uint64, bool, error) {
+	if !params.FanoutBudgetEnabled() {
+		return 0, false, nil
+	}
+
+	window := params.FanoutWindow(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	usage, err := k.GetFanoutUsage(ctx, window, addr)
+	if err != nil {
+		return 0, true, err
+	}
+	switch {
+	case usage.Used >= params.FanoutBudget:
+		// the budget may have been lowered by governance
+		return 0, true, nil
+	default:
+		return params.FanoutBudget - usage.Used, true, nil
+	}
+}
+
+// ConsumeFanout charges fanout to the budget of sender for the current
+// window. It fails with ErrFanoutBudgetExceeded if the budget does not allow
+// it, and is a no-op if the fan-out budget is disabled.
+func (k Keeper) ConsumeFanout(ctx context.Context, sender sdk.AccAddress, fanout uint64) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	remaining, enabled, err := k.GetRemainingFanout(ctx, params, sender)
+	if err != nil || !enabled {
+		return err
+	}
+	if fanout > remaining {
+		return errorsmod.Wrapf(types.ErrFanoutBudgetExceeded,
+			"%s may send to %d more recipients until block %d, got %d",
+			sender, remaining, k.fanoutWindowEnd(ctx, params), fanout)
+	}
+
+	return k.SetFanoutUsage(ctx, sender, types.FanoutUsage{
+		Window: params.FanoutWindow(sdk.UnwrapSDKContext(ctx).BlockHeight()),
+		Used:   params.FanoutBudget - remaining + fanout,
+	})
+}
+
+// fanoutWindowEnd returns the first block height of the next window.
+func (k Keeper) fanoutWindowEnd(ctx context.Context, params types.Params) int64 {
+	window := params.FanoutWindow(sdk.UnwrapSDKContext(ctx).BlockHeight())
+	return int64((window + 1) * params.FanoutWindowBlocks)
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/bank/keeper"
+	"github.com/cosmos/gaia/v29/x/bank/types"
+)
+
+func TestFanoutBudget(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	k := gaiaApp.GaiaBankKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	querier := keeper.NewQuerier(k)
+
+	sender := sdk.AccAddress([]byte("fanout_sender_______"))
+	recipient := sdk.AccAddress([]byte("fanout_recipient____"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, funds))
+
+	queryRemaining := func(ctx sdk.Context) *types.QueryFanoutBudgetResponse {
+		resp, err := querier.FanoutBudget(ctx, &types.QueryFanoutBudgetRequest{Address: sender.String()})
+		require.NoError(t, err)
+		return resp
+	}
+	batchSend := func(ctx sdk.Context, n int) error {
+		transfers := make([]types.BatchSendTransfer, n)
+		for i := range transfers {
+			transfers[i] = types.NewBatchSendTransfer(recipient.String(), sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)), "")
+		}
+		_, err := msgServer.BatchSend(ctx, types.NewMsgBatchSend(sender.String(), transfers...))
+		return err
+	}
+	multiSend := func(ctx sdk.Context, n int) error {
+		outputs := make([]banktypes.Output, n)
+		for i := range outputs {
+			outputs[i] = banktypes.NewOutput(recipient, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))
+		}
+		msg := banktypes.NewMsgMultiSend(banktypes.NewInput(sender, sdk.NewCoins(sdk.NewInt64Coin("uatom", int64(n)))), outputs)
+		_, err := gaiaApp.MsgServiceRouter().Handler(msg)(ctx, msg)
+		return err
+	}
+
+	// the budget is disabled by default
+	require.Equal(t, &types.QueryFanoutBudgetResponse{}, queryRemaining(ctx))
+	require.NoError(t, batchSend(ctx, 10))
+	usage, err := k.GetFanoutUsage(ctx, 0, sender)
+	require.NoError(t, err)
+	require.Equal(t, types.FanoutUsage{}, usage)
+
+	params := types.DefaultParams()
+	params.FanoutBudget = 10
+	params.FanoutWindowBlocks = 100
+	require.NoError(t, k.SetParams(ctx, params))
+	require.Equal(t, &types.QueryFanoutBudgetResponse{Budget: 10, Remaining: 10, WindowEndHeight: 100}, queryRemaining(ctx))
+
+	// MultiSend and BatchSend share the budget
+	require.NoError(t, batchSend(ctx, 4))
+	require.NoError(t, multiSend(ctx, 4))
+	require.Equal(t, uint64(2), queryRemaining(ctx).Remaining)
+
+	err = batchSend(ctx, 3)
+	require.ErrorIs(t, err, types.ErrFanoutBudgetExceeded)
+	err = multiSend(ctx, 3)
+	require.ErrorIs(t, err, types.ErrFanoutBudgetExceeded)
+	require.NoError(t, batchSend(ctx, 2))
+	require.Equal(t, uint64(0), queryRemaining(ctx).Remaining)
+
+	// the budget is replenished in the next window
+	nextWindow := ctx.WithBlockHeight(100)
+	require.Equal(t, &types.QueryFanoutBudgetResponse{Budget: 10, Remaining: 10, WindowEndHeight: 200}, queryRemaining(nextWindow))
+	require.NoError(t, multiSend(nextWindow, 10))
+	require.Equal(t, uint64(0), queryRemaining(nextWindow).Remaining)
+
+	// the usages of past windows are pruned
+	require.NoError(t, k.PruneFanoutUsages(nextWindow))
+	usage, err = k.GetFanoutUsage(nextWindow, 0, sender)
+	require.NoError(t, err)
+	require.Equal(t, types.FanoutUsage{}, usage)
+	usage, err = k.GetFanoutUsage(nextWindow, 1, sender)
+	require.NoError(t, err)
+	require.Equal(t, types.FanoutUsage{Window: 1, Used: 10}, usage)
+
+	// and all of them once the budget is disabled
+	require.NoError(t, k.SetParams(nextWindow, types.DefaultParams()))
+	require.NoError(t, k.PruneFanoutUsages(nextWindow))
+	usage, err = k.GetFanoutUsage(nextWindow, 1, sender)
+	require.NoError(t, err)
+	require.Equal(t, types.FanoutUsage{}, usage)
+}
@@ -0,0 +1,61 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/bank/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries the gaiabank parameters
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// FanoutBudget queries the fan-out an account may still send in the current window
+func (k Querier) FanoutBudget(ctx context.Context, req *types.QueryFanoutBudgetRequest) (*types.QueryFanoutBudgetResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	remaining, enabled, err := k.GetRemainingFanout(ctx, params, addr)
+	if err != nil {
+		return nil, err
+	}
+	if !enabled {
+		return &types.QueryFanoutBudgetResponse{}, nil
+	}
+
+	return &types.QueryFanoutBudgetResponse{
+		Budget:          params.FanoutBudget,
+		Remaining:       remaining,
+		WindowEndHeight: k.fanoutWindowEnd(ctx, params),
+	}, nil
+}
@@ -0,0 +1,114 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v29/x/bank/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the gaiabank MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateParams defines a method to perform updating of params for the x/gaiabank module.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	// store params
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// BatchSend sends coins from the sender to every recipient in a single bank
+// transfer. Unlike MsgMultiSend it is charged a linear gas amount per transfer
+// and emits one batch_send_transfer event per transfer carrying its reference ID.
+func (k msgServer) BatchSend(goCtx context.Context, msg *types.MsgBatchSend) (*types.MsgBatchSendResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", err)
+	}
+	if len(msg.Transfers) == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "no transfers")
+	}
+	if uint64(len(msg.Transfers)) > params.BatchSendMaxRecipients {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"too many recipients; got %d, max %d", len(msg.Transfers), params.BatchSendMaxRecipients)
+	}
+
+	// charge the per-transfer gas before doing any work per transfer
+	gas, err := params.BatchSendGas(uint64(len(msg.Transfers)))
+	if err != nil {
+		return nil, err
+	}
+	ctx.GasMeter().ConsumeGas(gas, "BatchSend per-recipient gas")
+
+	// validate every transfer before moving any funds
+	total := sdk.NewCoins()
+	outputs := make([]banktypes.Output, len(msg.Transfers))
+	for i, transfer := range msg.Transfers {
+		if err := transfer.Validate(); err != nil {
+			return nil, errorsmod.Wrapf(err, "transfer %d", i)
+		}
+		recipient := sdk.MustAccAddressFromBech32(transfer.Recipient)
+		if k.bankKeeper.BlockedAddr(recipient) {
+			return nil, errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "%s is not allowed to receive funds", transfer.Recipient)
+		}
+		total = total.Add(transfer.Amount...)
+		outputs[i] = banktypes.NewOutput(recipient, transfer.Amount)
+	}
+	if err := k.bankKeeper.IsSendEnabledCoins(ctx, total...); err != nil {
+		return nil, err
+	}
+	if err := k.ConsumeFanout(ctx, sender, uint64(len(msg.Transfers))); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.InputOutputCoins(ctx, banktypes.NewInput(sender, total), outputs); err != nil {
+		return nil, err
+	}
+
+	events := make(sdk.Events, len(msg.Transfers))
+	for i, transfer := range msg.Transfers {
+		events[i] = sdk.NewEvent(
+			types.EventTypeBatchSendTransfer,
+			sdk.NewAttribute(types.AttributeKeySender, msg.Sender),
+			sdk.NewAttribute(types.AttributeKeyRecipient, transfer.Recipient),
+			sdk.NewAttribute(types.AttributeKeyAmount, transfer.Amount.String()),
+			sdk.NewAttribute(types.AttributeKeyReferenceID, transfer.ReferenceId),
+		)
+	}
+	ctx.EventManager().EmitEvents(events)
+
+	return &types.MsgBatchSendResponse{}, nil
+}
@@ -0,0 +1,192 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/bank/keeper"
+	"github.com/cosmos/gaia/v29/x/bank/types"
+)
+
+func TestUpdateParams(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{})
+	k := gaiaApp.GaiaBankKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	querier := keeper.NewQuerier(k)
+
+	resp, err := querier.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.DefaultParams(), resp.Params)
+
+	newParams := types.NewParams(1000, 10, 100, 1000, 500, 10)
+	testCases := []struct {
+		name      string
+		msg       *types.MsgUpdateParams
+		expectErr string
+	}{
+		{
+			name:      "invalid authority",
+			msg:       &types.MsgUpdateParams{Authority: sdk.AccAddress("invalid").String(), Params: newParams},
+			expectErr: "invalid authority",
+		},
+		{
+			name:      "invalid params",
+			msg:       &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.NewParams(0, 10, 100, 1000, 500, 10)},
+			expectErr: "max recipients must be positive",
+		},
+		{
+			name:      "multi send gas factor too high",
+			msg:       &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.NewParams(1000, types.MaxMultiSendGasFactor+1, 100, 1000, 500, 10)},
+			expectErr: "multi send gas factor must not exceed",
+		},
+		{
+			name:      "batch send gas per recipient too high",
+			msg:       &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: types.NewParams(1000, 10, 100, types.MaxBatchSendGasPerRecipient+1, 500, 10)},
+			expectErr: "batch send gas per recipient must not exceed",
+		},
+		{
+			name: "valid params",
+			msg:  &types.MsgUpdateParams{Authority: k.GetAuthority(), Params: newParams},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.UpdateParams(ctx, tc.msg)
+			if tc.expectErr != "" {
+				require.ErrorContains(t, err, tc.expectErr)
+				return
+			}
+			require.NoError(t, err)
+
+			resp, err := querier.Params(ctx, &types.QueryParamsRequest{})
+			require.NoError(t, err)
+			require.Equal(t, tc.msg.Params, resp.Params)
+		})
+	}
+}
+
+func TestBatchSend(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{})
+	k := gaiaApp.GaiaBankKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+
+	sender := sdk.AccAddress([]byte("batch_sender________"))
+	recipient1 := sdk.AccAddress([]byte("batch_recipient1____"))
+	recipient2 := sdk.AccAddress([]byte("batch_recipient2____"))
+	blocked := gaiaApp.AccountKeeper.GetModuleAddress(distrtypes.ModuleName)
+
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1000))
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, sender, funds))
+
+	coins := func(amt int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uatom", amt)) }
+
+	err := k.SetParams(ctx, types.NewParams(
+		types.DefaultMultiSendMaxRecipients, types.DefaultMultiSendGasFactor,
+		2, 1000,
+		types.DefaultFanoutBudget, types.DefaultFanoutWindowBlocks,
+	))
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name      string
+		msg       *types.MsgBatchSend
+		expectErr error
+	}{
+		{
+			name:      "no transfers",
+			msg:       types.NewMsgBatchSend(sender.String()),
+			expectErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "too many recipients",
+			msg: types.NewMsgBatchSend(sender.String(),
+				types.NewBatchSendTransfer(recipient1.String(), coins(1), ""),
+				types.NewBatchSendTransfer(recipient2.String(), coins(1), ""),
+				types.NewBatchSendTransfer(recipient1.String(), coins(1), ""),
+			),
+			expectErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "blocked recipient",
+			msg: types.NewMsgBatchSend(sender.String(),
+				types.NewBatchSendTransfer(recipient1.String(), coins(1), ""),
+				types.NewBatchSendTransfer(blocked.String(), coins(1), ""),
+			),
+			expectErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name: "invalid amount",
+			msg: types.NewMsgBatchSend(sender.String(),
+				types.NewBatchSendTransfer(recipient1.String(), sdk.Coins{}, ""),
+			),
+			expectErr: sdkerrors.ErrInvalidCoins,
+		},
+		{
+			name: "insufficient funds",
+			msg: types.NewMsgBatchSend(sender.String(),
+				types.NewBatchSendTransfer(recipient1.String(), coins(2000), ""),
+			),
+			expectErr: sdkerrors.ErrInsufficientFunds,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := msgServer.BatchSend(ctx, tc.msg)
+			require.ErrorIs(t, err, tc.expectErr)
+			require.Equal(t, funds, gaiaApp.BankKeeper.GetAllBalances(ctx, sender))
+		})
+	}
+
+	t.Run("gas charged before validating transfers", func(t *testing.T) {
+		ctx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+		msg := types.NewMsgBatchSend(sender.String(),
+			types.NewBatchSendTransfer(recipient1.String(), coins(1), ""),
+			types.NewBatchSendTransfer(blocked.String(), coins(1), ""),
+		)
+		_, err := msgServer.BatchSend(ctx, msg)
+		require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+		require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), uint64(2*1000))
+	})
+
+	t.Run("valid", func(t *testing.T) {
+		ctx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()).WithEventManager(sdk.NewEventManager())
+		msg := types.NewMsgBatchSend(sender.String(),
+			types.NewBatchSendTransfer(recipient1.String(), coins(100), "payout-1"),
+			types.NewBatchSendTransfer(recipient2.String(), coins(250), "payout-2"),
+		)
+		_, err := msgServer.BatchSend(ctx, msg)
+		require.NoError(t, err)
+		require.GreaterOrEqual(t, ctx.GasMeter().GasConsumed(), uint64(2*1000))
+
+		require.Equal(t, coins(650), gaiaApp.BankKeeper.GetAllBalances(ctx, sender))
+		require.Equal(t, coins(100), gaiaApp.BankKeeper.GetAllBalances(ctx, recipient1))
+		require.Equal(t, coins(250), gaiaApp.BankKeeper.GetAllBalances(ctx, recipient2))
+
+		var referenceIDs []string
+		for _, event := range ctx.EventManager().Events() {
+			if event.Type != types.EventTypeBatchSendTransfer {
+				continue
+			}
+			attr, ok := event.GetAttribute(types.AttributeKeyReferenceID)
+			require.True(t, ok)
+			referenceIDs = append(referenceIDs, attr.Value)
+		}
+		require.Equal(t, []string{"payout-1", "payout-2"}, referenceIDs)
+	})
+}
@@ -0,0 +1,34 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/gaia/v29/x/bank/types"
+)
+
+// SetParams sets the x/gaiabank module parameters.
+// CONTRACT: This method performs no validation of the parameters.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.ParamsKey, bz)
+}
+
+// GetParams gets the x/gaiabank module parameters.
+func (k Keeper) GetParams(ctx context.Context) (params types.Params, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil {
+		return params, err
+	}
+
+	if bz == nil {
+		return types.DefaultParams(), nil
+	}
+
+	err = k.cdc.Unmarshal(bz, &params)
+	return params, err
+}
@@ -3,22 +3,58 @@
 // MsgMultiSend at the MsgServer level. This ensures all multi-send
 // messages are subject to anti-spam controls regardless of their
 // origin (user tx, ICA, wasm, authz, or any future mechanism).
+//
+// The limits are governance controlled parameters owned by the
+// gaiabank ExtensionModule, which is registered alongside the
+// wrapped bank module. The ExtensionModule also provides MsgBatchSend,
+// a batched send with linear per-recipient gas and a reference ID per
+// transfer, and keeps the optional per-account fan-out budget shared by
+// MsgMultiSend and MsgBatchSend.
 package bank
 
 import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+	"github.com/spf13/cobra"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/gaia/v29/x/bank/client/cli"
+	"github.com/cosmos/gaia/v29/x/bank/keeper"
+	"github.com/cosmos/gaia/v29/x/bank/types"
+)
+
+const (
+	consensusVersion uint64 = 1
+)
+
+var (
+	_ module.AppModuleBasic = ExtensionModuleBasic{}
+	_ module.HasServices    = ExtensionModule{}
+	_ module.HasGenesis     = ExtensionModule{}
+
+	_ appmodule.AppModule       = ExtensionModule{}
+	_ appmodule.HasBeginBlocker = ExtensionModule{}
 )
 
 // AppModule wraps the standard bank module to intercept RegisterServices
 type AppModule struct {
 	bank.AppModule
-	keeper bankkeeper.Keeper
-	config MultiSendConfig
+	keeper     bankkeeper.Keeper
+	gaiaKeeper *keeper.Keeper
 
 	// legacySubspace is used solely for migration of x/params managed parameters
 	legacySubspace paramstypes.Subspace
@@ -29,13 +65,14 @@ type AppModule struct {
 func NewAppModule(
 	cdc codec.Codec,
 	keeper bankkeeper.Keeper,
+	gaiaKeeper *keeper.Keeper,
 	ak banktypes.AccountKeeper,
 	ss paramstypes.Subspace,
 ) AppModule {
 	return AppModule{
 		AppModule:      bank.NewAppModule(cdc, keeper, ak, ss),
 		keeper:         keeper,
-		config:         DefaultMultiSendConfig(),
+		gaiaKeeper:     gaiaKeeper,
 		legacySubspace: ss,
 	}
 }
@@ -49,8 +86,106 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	standardMsgServer := bankkeeper.NewMsgServerImpl(am.keeper)
 
 	// Wrap the standard MsgServer with our custom logic
-	wrappedMsgServer := NewMsgServerWrapper(standardMsgServer, am.config)
+	wrappedMsgServer := NewMsgServerWrapper(standardMsgServer, am.gaiaKeeper)
 
 	// Register our wrapped MsgServer
 	banktypes.RegisterMsgServer(cfg.MsgServer(), wrappedMsgServer)
 }
+
+// ExtensionModuleBasic defines the basic application module of the Gaia bank
+// extensions.
+type ExtensionModuleBasic struct{}
+
+// Name returns the Gaia bank extensions' module name.
+func (ExtensionModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterLegacyAminoCodec registers the Gaia bank extensions' types on the given LegacyAmino codec.
+func (ExtensionModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the module's interface types
+func (ExtensionModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the Gaia bank
+// extensions.
+func (ExtensionModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the Gaia bank extensions.
+func (ExtensionModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return types.ValidateGenesis(&data)
+}
+
+// GetTxCmd returns the root tx command for the Gaia bank extensions.
+func (ExtensionModuleBasic) GetTxCmd() *cobra.Command {
+	return cli.NewTxCmd()
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the Gaia bank extensions.
+func (ExtensionModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// ExtensionModule holds the Gaia specific bank state, i.e. the MultiSend
+// and BatchSend parameters and the fan-out used by each account, under its
+// own module name and store, and serves MsgBatchSend.
+type ExtensionModule struct {
+	ExtensionModuleBasic
+
+	keeper *keeper.Keeper
+}
+
+// NewExtensionModule creates a new ExtensionModule object
+func NewExtensionModule(keeper *keeper.Keeper) ExtensionModule {
+	return ExtensionModule{
+		keeper: keeper,
+	}
+}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am ExtensionModule) IsOnePerModuleType() {}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (am ExtensionModule) IsAppModule() {}
+
+// RegisterServices registers module services.
+func (am ExtensionModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+}
+
+// InitGenesis performs genesis initialization for the Gaia bank extensions.
+func (am ExtensionModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
+	var genesisState types.GenesisState
+
+	cdc.MustUnmarshalJSON(data, &genesisState)
+
+	am.keeper.InitGenesis(ctx, &genesisState)
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the Gaia
+// bank extensions.
+func (am ExtensionModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (ExtensionModule) ConsensusVersion() uint64 { return consensusVersion }
+
+// BeginBlock prunes the fan-out used in past windows.
+func (am ExtensionModule) BeginBlock(ctx context.Context) error {
+	return am.keeper.PruneFanoutUsages(ctx)
+}
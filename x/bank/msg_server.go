@@ -8,49 +8,71 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v29/x/bank/types"
 )
 
-// MultiSendConfig defines configuration for the MultiSend decorator
-type MultiSendConfig struct {
-	MaxRecipients int
-	GasFactor     uint64 // Quadratic gas factor "A" in: Gas = A * N^2
-}
-
-// DefaultMultiSendConfig returns the default configuration
-func DefaultMultiSendConfig() MultiSendConfig {
-	return MultiSendConfig{
-		MaxRecipients: 500, // Limit to 500 recipients per transaction
-		GasFactor:     300, // Default quadratic factor
-	}
+// GaiaBankKeeper provides the governance controlled MultiSend limits and
+// the per-account fan-out budget
+type GaiaBankKeeper interface {
+	GetParams(ctx context.Context) (types.Params, error)
+	ConsumeFanout(ctx context.Context, sender sdk.AccAddress, fanout uint64) error
 }
 
 // MsgServerWrapper wraps the standard bank MsgServer
 type MsgServerWrapper struct {
 	banktypes.MsgServer
-	config MultiSendConfig
+	gaiaKeeper GaiaBankKeeper
 }
 
 // NewMsgServerWrapper creates a new MsgServer wrapper
-func NewMsgServerWrapper(keeper banktypes.MsgServer, config MultiSendConfig) MsgServerWrapper {
+func NewMsgServerWrapper(keeper banktypes.MsgServer, gaiaKeeper GaiaBankKeeper) MsgServerWrapper {
 	return MsgServerWrapper{
-		MsgServer: keeper,
-		config:    config,
+		MsgServer:  keeper,
+		gaiaKeeper: gaiaKeeper,
 	}
 }
 
+// MultiSendFanout returns the number of transfers performed by a MsgMultiSend,
+// counted as inputs times outputs.
+func MultiSendFanout(msg *banktypes.MsgMultiSend) uint64 {
+	return uint64(len(msg.Inputs)) * uint64(len(msg.Outputs))
+}
+
 // MultiSend intercepts and enforces controls on MsgMultiSend
 func (s MsgServerWrapper) MultiSend(goCtx context.Context, msg *banktypes.MsgMultiSend) (*banktypes.MsgMultiSendResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
+	params, err := s.gaiaKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	// Check max recipients
-	if len(msg.Outputs) > s.config.MaxRecipients {
-		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "too many recipients in MultiSend: max %d, got %d", s.config.MaxRecipients, len(msg.Outputs))
+	n := MultiSendFanout(msg)
+	if n > params.MultiSendMaxRecipients {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "too many recipients in MultiSend: max %d, got %d", params.MultiSendMaxRecipients, n)
+	}
+
+	// Charge the fan-out to the budget of every sender
+	if params.FanoutBudgetEnabled() {
+		for _, input := range msg.Inputs {
+			sender, err := sdk.AccAddressFromBech32(input.Address)
+			if err != nil {
+				return nil, errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid input address: %s", err)
+			}
+			if err := s.gaiaKeeper.ConsumeFanout(ctx, sender, n); err != nil {
+				return nil, err
+			}
+		}
 	}
 
 	// Apply Quadratic Gas Surcharge: A * N^2
-	n := uint64(len(msg.Outputs))
 	if n > 0 {
-		surcharge := s.config.GasFactor * n * n
+		surcharge, err := params.MultiSendGasSurcharge(n)
+		if err != nil {
+			return nil, err
+		}
 		ctx.GasMeter().ConsumeGas(surcharge, "MultiSend quadratic surcharge")
 	}
 
@@ -2,6 +2,7 @@ package bank_test
 
 import (
 	"context"
+	"math"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -12,6 +13,7 @@ import (
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
 	gaiabank "github.com/cosmos/gaia/v29/x/bank"
+	gaiabanktypes "github.com/cosmos/gaia/v29/x/bank/types"
 )
 
 // MockMsgServer mocks basic MsgServer functionality
@@ -23,6 +25,27 @@ func (m MockMsgServer) MultiSend(ctx context.Context, msg *banktypes.MsgMultiSen
 	return &banktypes.MsgMultiSendResponse{}, nil
 }
 
+// MockGaiaBankKeeper returns fixed MultiSend limits and has no fan-out budget
+type MockGaiaBankKeeper struct {
+	params gaiabanktypes.Params
+}
+
+func (m MockGaiaBankKeeper) GetParams(context.Context) (gaiabanktypes.Params, error) {
+	return m.params, nil
+}
+
+func (m MockGaiaBankKeeper) ConsumeFanout(context.Context, sdk.AccAddress, uint64) error {
+	return nil
+}
+
+func multiSendParams(maxRecipients, gasFactor uint64) MockGaiaBankKeeper {
+	return MockGaiaBankKeeper{gaiabanktypes.NewParams(
+		maxRecipients, gasFactor,
+		gaiabanktypes.DefaultBatchSendMaxRecipients, gaiabanktypes.DefaultBatchSendGasPerRecipient,
+		gaiabanktypes.DefaultFanoutBudget, gaiabanktypes.DefaultFanoutWindowBlocks,
+	)}
+}
+
 func TestMsgServerWrapper(t *testing.T) {
 	mockHelper := MockMsgServer{}
 
@@ -32,12 +55,13 @@ func TestMsgServerWrapper(t *testing.T) {
 	}
 
 	t.Run("MaxRecipients", func(t *testing.T) {
-		config := gaiabank.MultiSendConfig{MaxRecipients: 2, GasFactor: 1000}
-		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, config)
+		params := multiSendParams(2, 1000)
+		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, params)
 		ctx := setupCtx()
 
 		// 3 recipients > 2
 		msg := &banktypes.MsgMultiSend{
+			Inputs:  make([]banktypes.Input, 1),
 			Outputs: make([]banktypes.Output, 3),
 		}
 		_, err := wrapper.MultiSend(ctx, msg)
@@ -46,12 +70,13 @@ func TestMsgServerWrapper(t *testing.T) {
 	})
 
 	t.Run("GasSurcharge_DefaultFactor", func(t *testing.T) {
-		config := gaiabank.MultiSendConfig{MaxRecipients: 100, GasFactor: 1000}
-		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, config)
+		params := multiSendParams(100, 1000)
+		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, params)
 		ctx := setupCtx()
 
 		// 10 recipients -> 1000 * 10^2 = 100,000 gas
 		msg := &banktypes.MsgMultiSend{
+			Inputs:  make([]banktypes.Input, 1),
 			Outputs: make([]banktypes.Output, 10),
 		}
 
@@ -65,12 +90,13 @@ func TestMsgServerWrapper(t *testing.T) {
 	})
 
 	t.Run("GasSurcharge_CustomFactor", func(t *testing.T) {
-		config := gaiabank.MultiSendConfig{MaxRecipients: 100, GasFactor: 500}
-		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, config)
+		params := multiSendParams(100, 500)
+		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, params)
 		ctx := setupCtx()
 
 		// 10 recipients -> 500 * 10^2 = 50,000 gas
 		msg := &banktypes.MsgMultiSend{
+			Inputs:  make([]banktypes.Input, 1),
 			Outputs: make([]banktypes.Output, 10),
 		}
 
@@ -83,12 +109,41 @@ func TestMsgServerWrapper(t *testing.T) {
 		require.Equal(t, expectedSurcharge, gasAfter-gasBefore, "Should consume custom quadratic gas")
 	})
 
+	t.Run("GasSurcharge_Overflow", func(t *testing.T) {
+		params := multiSendParams(math.MaxUint64, math.MaxUint64)
+		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, params)
+		ctx := setupCtx()
+
+		msg := &banktypes.MsgMultiSend{
+			Inputs:  make([]banktypes.Input, 1),
+			Outputs: make([]banktypes.Output, 10),
+		}
+		_, err := wrapper.MultiSend(ctx, msg)
+		require.ErrorIs(t, err, gaiabanktypes.ErrGasOverflow)
+	})
+
+	t.Run("MaxRecipients_InputsTimesOutputs", func(t *testing.T) {
+		params := multiSendParams(10, 1000)
+		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, params)
+		ctx := setupCtx()
+
+		// 2 inputs * 6 outputs = 12 > 10
+		msg := &banktypes.MsgMultiSend{
+			Inputs:  make([]banktypes.Input, 2),
+			Outputs: make([]banktypes.Output, 6),
+		}
+		_, err := wrapper.MultiSend(ctx, msg)
+		require.Error(t, err)
+		require.Contains(t, err.Error(), "too many recipients")
+	})
+
 	t.Run("PassThrough", func(t *testing.T) {
-		config := gaiabank.MultiSendConfig{MaxRecipients: 50, GasFactor: 1000}
-		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, config)
+		params := multiSendParams(50, 1000)
+		wrapper := gaiabank.NewMsgServerWrapper(mockHelper, params)
 		ctx := setupCtx()
 
 		msg := &banktypes.MsgMultiSend{
+			Inputs:  make([]banktypes.Input, 1),
 			Outputs: make([]banktypes.Output, 1),
 		}
 		_, err := wrapper.MultiSend(ctx, msg)
@@ -0,0 +1,733 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/bank/v1beta1/bank.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the Gaia bank extensions.
+type Params struct {
+	// multi_send_max_recipients is the maximum fan-out of a MsgMultiSend,
+	// counted as the number of inputs times the number of outputs.
+	MultiSendMaxRecipients uint64 `protobuf:"varint,1,opt,name=multi_send_max_recipients,json=multiSendMaxRecipients,proto3" json:"multi_send_max_recipients,omitempty"`
+	// multi_send_gas_factor is the factor A of the quadratic gas surcharge
+	// A * N^2 charged for a MsgMultiSend with a fan-out of N.
+	MultiSendGasFactor uint64 `protobuf:"varint,2,opt,name=multi_send_gas_factor,json=multiSendGasFactor,proto3" json:"multi_send_gas_factor,omitempty"`
+	// batch_send_max_recipients is the maximum number of transfers in a
+	// MsgBatchSend.
+	BatchSendMaxRecipients uint64 `protobuf:"varint,3,opt,name=batch_send_max_recipients,json=batchSendMaxRecipients,proto3" json:"batch_send_max_recipients,omitempty"`
+	// batch_send_gas_per_recipient is the gas charged for each transfer of a
+	// MsgBatchSend, on top of the gas consumed by the transfer itself.
+	BatchSendGasPerRecipient uint64 `protobuf:"varint,4,opt,name=batch_send_gas_per_recipient,json=batchSendGasPerRecipient,proto3" json:"batch_send_gas_per_recipient,omitempty"`
+	// fanout_budget is the total fan-out an account may send through
+	// MsgMultiSend and MsgBatchSend within one window. Zero disables the budget.
+	FanoutBudget uint64 `protobuf:"varint,5,opt,name=fanout_budget,json=fanoutBudget,proto3" json:"fanout_budget,omitempty"`
+	// fanout_window_blocks is the length of a fan-out budget window, in blocks.
+	FanoutWindowBlocks uint64 `protobuf:"varint,6,opt,name=fanout_window_blocks,json=fanoutWindowBlocks,proto3" json:"fanout_window_blocks,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8033a350c79c894a, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetMultiSendMaxRecipients() uint64 {
+	if m != nil {
+		return m.MultiSendMaxRecipients
+	}
+	return 0
+}
+
+func (m *Params) GetMultiSendGasFactor() uint64 {
+	if m != nil {
+		return m.MultiSendGasFactor
+	}
+	return 0
+}
+
+func (m *Params) GetBatchSendMaxRecipients() uint64 {
+	if m != nil {
+		return m.BatchSendMaxRecipients
+	}
+	return 0
+}
+
+func (m *Params) GetBatchSendGasPerRecipient() uint64 {
+	if m != nil {
+		return m.BatchSendGasPerRecipient
+	}
+	return 0
+}
+
+func (m *Params) GetFanoutBudget() uint64 {
+	if m != nil {
+		return m.FanoutBudget
+	}
+	return 0
+}
+
+func (m *Params) GetFanoutWindowBlocks() uint64 {
+	if m != nil {
+		return m.FanoutWindowBlocks
+	}
+	return 0
+}
+
+// FanoutUsage is the fan-out an account has used in its current window.
+type FanoutUsage struct {
+	// window is the index of the window, i.e. the block height divided by
+	// fanout_window_blocks.
+	Window uint64 `protobuf:"varint,1,opt,name=window,proto3" json:"window,omitempty"`
+	// used is the fan-out sent by the account within the window.
+	Used uint64 `protobuf:"varint,2,opt,name=used,proto3" json:"used,omitempty"`
+}
+
+func (m *FanoutUsage) Reset()         { *m = FanoutUsage{} }
+func (m *FanoutUsage) String() string { return proto.CompactTextString(m) }
+func (*FanoutUsage) ProtoMessage()    {}
+func (*FanoutUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8033a350c79c894a, []int{1}
+}
+func (m *FanoutUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FanoutUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FanoutUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FanoutUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FanoutUsage.Merge(m, src)
+}
+func (m *FanoutUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *FanoutUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_FanoutUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FanoutUsage proto.InternalMessageInfo
+
+func (m *FanoutUsage) GetWindow() uint64 {
+	if m != nil {
+		return m.Window
+	}
+	return 0
+}
+
+func (m *FanoutUsage) GetUsed() uint64 {
+	if m != nil {
+		return m.Used
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gaia.bank.v1beta1.Params")
+	proto.RegisterType((*FanoutUsage)(nil), "gaia.bank.v1beta1.FanoutUsage")
+}
+
+func init() { proto.RegisterFile("gaia/bank/v1beta1/bank.proto", fileDescriptor_8033a350c79c894a) }
+
+var fileDescriptor_8033a350c79c894a = []byte{
+	// 380 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x91, 0xc1, 0x4e, 0xea, 0x40,
+	0x14, 0x86, 0x29, 0x97, 0xdb, 0xc5, 0xdc, 0xeb, 0x82, 0x09, 0x92, 0x4a, 0x48, 0x31, 0xb0, 0x31,
+	0x2e, 0xa8, 0xc4, 0x15, 0x26, 0xba, 0x60, 0x01, 0x2b, 0x13, 0x82, 0x31, 0x26, 0x6e, 0x9a, 0xd3,
+	0x76, 0x28, 0x0d, 0xb4, 0xd3, 0x74, 0xa6, 0x82, 0xaf, 0xe0, 0xca, 0x47, 0xf0, 0x11, 0x7c, 0x0c,
+	0x97, 0x2c, 0x5d, 0x1a, 0x58, 0xa8, 0x6f, 0x61, 0x7a, 0xa6, 0xa9, 0x24, 0xba, 0x69, 0x67, 0xce,
+	0xff, 0x7f, 0xe7, 0xcf, 0x9c, 0x43, 0x9a, 0x3e, 0x04, 0x60, 0x39, 0x10, 0xcd, 0xad, 0xbb, 0x9e,
+	0xc3, 0x24, 0xf4, 0xf0, 0xd2, 0x8d, 0x13, 0x2e, 0x39, 0xad, 0x66, 0x6a, 0x17, 0x0b, 0xb9, 0xda,
+	0xa8, 0xf9, 0xdc, 0xe7, 0xa8, 0x5a, 0xd9, 0x49, 0x19, 0x1b, 0x55, 0x08, 0x83, 0x88, 0x5b, 0xf8,
+	0x55, 0xa5, 0xf6, 0x67, 0x99, 0xe8, 0x63, 0x48, 0x20, 0x14, 0xb4, 0x4f, 0x0e, 0xc2, 0x74, 0x21,
+	0x03, 0x5b, 0xb0, 0xc8, 0xb3, 0x43, 0x58, 0xd9, 0x09, 0x73, 0x83, 0x38, 0x60, 0x91, 0x14, 0x86,
+	0x76, 0xa8, 0x1d, 0x55, 0x26, 0x75, 0x34, 0x5c, 0xb1, 0xc8, 0xbb, 0x84, 0xd5, 0xa4, 0x50, 0x69,
+	0x8f, 0xec, 0xef, 0xa0, 0x3e, 0x08, 0x7b, 0x0a, 0xae, 0xe4, 0x89, 0x51, 0x46, 0x8c, 0x16, 0xd8,
+	0x08, 0xc4, 0x10, 0x95, 0x2c, 0xcd, 0x01, 0xe9, 0xce, 0x7e, 0x4d, 0xfb, 0xa3, 0xd2, 0xd0, 0xf0,
+	0x33, 0xed, 0x82, 0x34, 0x77, 0xd0, 0x2c, 0x2d, 0x66, 0xc9, 0x37, 0x6e, 0x54, 0x90, 0x36, 0x0a,
+	0x7a, 0x04, 0x62, 0xcc, 0x92, 0xa2, 0x01, 0xed, 0x90, 0xbd, 0x29, 0x44, 0x3c, 0x95, 0xb6, 0x93,
+	0x7a, 0x3e, 0x93, 0xc6, 0x5f, 0x04, 0xfe, 0xab, 0xe2, 0x00, 0x6b, 0xf4, 0x84, 0xd4, 0x72, 0xd3,
+	0x32, 0x88, 0x3c, 0xbe, 0xb4, 0x9d, 0x05, 0x77, 0xe7, 0xc2, 0xd0, 0xd5, 0x8b, 0x94, 0x76, 0x83,
+	0xd2, 0x00, 0x95, 0xb3, 0xd6, 0xc7, 0x53, 0x4b, 0x7b, 0x78, 0x7f, 0x3e, 0xae, 0xe3, 0xb6, 0x56,
+	0x56, 0xf6, 0xc3, 0x9d, 0xa9, 0x01, 0xb7, 0xfb, 0xe4, 0xdf, 0x10, 0xb1, 0x6b, 0x01, 0x3e, 0xa3,
+	0x75, 0xa2, 0xab, 0xd6, 0xf9, 0x70, 0xf3, 0x1b, 0xa5, 0xa4, 0x92, 0x0a, 0xe6, 0xe5, 0xb3, 0xc3,
+	0xf3, 0xe0, 0xfc, 0x65, 0x63, 0x6a, 0xeb, 0x8d, 0xa9, 0xbd, 0x6d, 0x4c, 0xed, 0x71, 0x6b, 0x96,
+	0xd6, 0x5b, 0xb3, 0xf4, 0xba, 0x35, 0x4b, 0xb7, 0x1d, 0x3f, 0x90, 0xb3, 0xd4, 0xe9, 0xba, 0x3c,
+	0xb4, 0x5c, 0x2e, 0x42, 0x2e, 0xac, 0x3c, 0x1e, 0xa3, 0xe5, 0x7d, 0xcc, 0x84, 0xa3, 0xe3, 0xb2,
+	0x4f, 0xbf, 0x06, 0x00, 0x7d, 0x71, 0xea, 0x68, 0x48, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.MultiSendMaxRecipients != that1.MultiSendMaxRecipients {
+		return false
+	}
+	if this.MultiSendGasFactor != that1.MultiSendGasFactor {
+		return false
+	}
+	if this.BatchSendMaxRecipients != that1.BatchSendMaxRecipients {
+		return false
+	}
+	if this.BatchSendGasPerRecipient != that1.BatchSendGasPerRecipient {
+		return false
+	}
+	if this.FanoutBudget != that1.FanoutBudget {
+		return false
+	}
+	if this.FanoutWindowBlocks != that1.FanoutWindowBlocks {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FanoutWindowBlocks != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.FanoutWindowBlocks))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.FanoutBudget != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.FanoutBudget))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.BatchSendGasPerRecipient != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.BatchSendGasPerRecipient))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchSendMaxRecipients != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.BatchSendMaxRecipients))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.MultiSendGasFactor != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MultiSendGasFactor))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.MultiSendMaxRecipients != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.MultiSendMaxRecipients))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FanoutUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FanoutUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FanoutUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Used != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Used))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Window != 0 {
+		i = encodeVarintBank(dAtA, i, uint64(m.Window))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBank(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBank(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.MultiSendMaxRecipients != 0 {
+		n += 1 + sovBank(uint64(m.MultiSendMaxRecipients))
+	}
+	if m.MultiSendGasFactor != 0 {
+		n += 1 + sovBank(uint64(m.MultiSendGasFactor))
+	}
+	if m.BatchSendMaxRecipients != 0 {
+		n += 1 + sovBank(uint64(m.BatchSendMaxRecipients))
+	}
+	if m.BatchSendGasPerRecipient != 0 {
+		n += 1 + sovBank(uint64(m.BatchSendGasPerRecipient))
+	}
+	if m.FanoutBudget != 0 {
+		n += 1 + sovBank(uint64(m.FanoutBudget))
+	}
+	if m.FanoutWindowBlocks != 0 {
+		n += 1 + sovBank(uint64(m.FanoutWindowBlocks))
+	}
+	return n
+}
+
+func (m *FanoutUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Window != 0 {
+		n += 1 + sovBank(uint64(m.Window))
+	}
+	if m.Used != 0 {
+		n += 1 + sovBank(uint64(m.Used))
+	}
+	return n
+}
+
+func sovBank(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBank(x uint64) (n int) {
+	return sovBank(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSendMaxRecipients", wireType)
+			}
+			m.MultiSendMaxRecipients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiSendMaxRecipients |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MultiSendGasFactor", wireType)
+			}
+			m.MultiSendGasFactor = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MultiSendGasFactor |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSendMaxRecipients", wireType)
+			}
+			m.BatchSendMaxRecipients = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSendMaxRecipients |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchSendGasPerRecipient", wireType)
+			}
+			m.BatchSendGasPerRecipient = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchSendGasPerRecipient |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FanoutBudget", wireType)
+			}
+			m.FanoutBudget = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FanoutBudget |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FanoutWindowBlocks", wireType)
+			}
+			m.FanoutWindowBlocks = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FanoutWindowBlocks |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FanoutUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FanoutUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FanoutUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Window", wireType)
+			}
+			m.Window = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Window |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Used", wireType)
+			}
+			m.Used = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Used |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBank(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBank
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBank(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBank
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBank
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBank
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBank
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBank
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBank        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBank          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBank = fmt.Errorf("proto: unexpected end of group")
+)
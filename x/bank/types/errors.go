@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/gaiabank module sentinel errors
+var (
+	ErrFanoutBudgetExceeded = errors.Register(ModuleName, 2, "fan-out budget exceeded")
+	ErrGasOverflow          = errors.Register(ModuleName, 3, "gas amount overflow")
+)
@@ -0,0 +1,11 @@
+package types
+
+// gaiabank module event types
+const (
+	EventTypeBatchSendTransfer = "batch_send_transfer"
+
+	AttributeKeySender      = "sender"
+	AttributeKeyRecipient   = "recipient"
+	AttributeKeyAmount      = "amount"
+	AttributeKeyReferenceID = "reference_id"
+)
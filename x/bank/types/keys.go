@@ -0,0 +1,35 @@
+package types
+
+import (
+	"encoding/binary"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// ModuleName is the name of the Gaia bank extensions module. It differs
+	// from the SDK bank module name, which is kept by the wrapped bank module.
+	ModuleName = "gaiabank"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the Gaia bank extensions
+	RouterKey = ModuleName
+)
+
+var (
+	ParamsKey         = []byte{0x01} // key for the parameters of the Gaia bank extensions
+	FanoutUsagePrefix = []byte{0x02} // prefix for the fan-out used by each account, by window
+)
+
+// GetFanoutWindowKey returns the prefix of the fan-out used in window
+func GetFanoutWindowKey(window uint64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, FanoutUsagePrefix...), window)
+}
+
+// GetFanoutUsageKey returns the key of the fan-out used by addr in window
+func GetFanoutUsageKey(window uint64, addr sdk.AccAddress) []byte {
+	return append(GetFanoutWindowKey(window), address.MustLengthPrefix(addr)...)
+}
@@ -0,0 +1,43 @@
+package types
+
+import (
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+)
+
+// MaxReferenceIDLength is the maximum length of a BatchSendTransfer reference ID
+const MaxReferenceIDLength = 256
+
+var _ sdk.Msg = (*MsgBatchSend)(nil)
+
+func NewMsgBatchSend(sender string, transfers ...BatchSendTransfer) *MsgBatchSend {
+	return &MsgBatchSend{
+		Sender:    sender,
+		Transfers: transfers,
+	}
+}
+
+func NewBatchSendTransfer(recipient string, amount sdk.Coins, referenceID string) BatchSendTransfer {
+	return BatchSendTransfer{
+		Recipient:   recipient,
+		Amount:      amount,
+		ReferenceId: referenceID,
+	}
+}
+
+// Validate performs the stateless checks of a transfer.
+func (t BatchSendTransfer) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(t.Recipient); err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address: %s", err)
+	}
+	if !t.Amount.IsValid() || !t.Amount.IsAllPositive() {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidCoins, t.Amount.String())
+	}
+	if len(t.ReferenceId) > MaxReferenceIDLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest,
+			"reference id too long; got %d, max %d", len(t.ReferenceId), MaxReferenceIDLength)
+	}
+	return nil
+}
@@ -0,0 +1,121 @@
+package types
+
+import (
+	"fmt"
+	"math/bits"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// DefaultMultiSendMaxRecipients limits a MsgMultiSend to 500 recipients
+	DefaultMultiSendMaxRecipients uint64 = 500
+	// DefaultMultiSendGasFactor is the default quadratic gas factor
+	DefaultMultiSendGasFactor uint64 = 300
+	// MaxMultiSendMaxRecipients is the highest MultiSendMaxRecipients allowed
+	MaxMultiSendMaxRecipients uint64 = 10_000
+	// MaxMultiSendGasFactor is the highest MultiSendGasFactor allowed
+	MaxMultiSendGasFactor uint64 = 100_000
+	// DefaultBatchSendMaxRecipients limits a MsgBatchSend to 5000 transfers
+	DefaultBatchSendMaxRecipients uint64 = 5000
+	// DefaultBatchSendGasPerRecipient is the default linear gas charged per transfer
+	DefaultBatchSendGasPerRecipient uint64 = 2000
+	// MaxBatchSendMaxRecipients is the highest BatchSendMaxRecipients allowed
+	MaxBatchSendMaxRecipients uint64 = 100_000
+	// MaxBatchSendGasPerRecipient is the highest BatchSendGasPerRecipient allowed
+	MaxBatchSendGasPerRecipient uint64 = 1_000_000
+	// DefaultFanoutBudget disables the per-account fan-out budget
+	DefaultFanoutBudget uint64 = 0
+	// DefaultFanoutWindowBlocks is the default fan-out budget window of 100 blocks
+	DefaultFanoutWindowBlocks uint64 = 100
+)
+
+// NewParams creates a new Params instance
+func NewParams(
+	multiSendMaxRecipients, multiSendGasFactor uint64,
+	batchSendMaxRecipients, batchSendGasPerRecipient uint64,
+	fanoutBudget, fanoutWindowBlocks uint64,
+) Params {
+	return Params{
+		MultiSendMaxRecipients:   multiSendMaxRecipients,
+		MultiSendGasFactor:       multiSendGasFactor,
+		BatchSendMaxRecipients:   batchSendMaxRecipients,
+		BatchSendGasPerRecipient: batchSendGasPerRecipient,
+		FanoutBudget:             fanoutBudget,
+		FanoutWindowBlocks:       fanoutWindowBlocks,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(
+		DefaultMultiSendMaxRecipients,
+		DefaultMultiSendGasFactor,
+		DefaultBatchSendMaxRecipients,
+		DefaultBatchSendGasPerRecipient,
+		DefaultFanoutBudget,
+		DefaultFanoutWindowBlocks,
+	)
+}
+
+// validate a set of params
+func (p Params) Validate() error {
+	if p.MultiSendMaxRecipients == 0 {
+		return fmt.Errorf("multi send max recipients must be positive")
+	}
+	if p.MultiSendMaxRecipients > MaxMultiSendMaxRecipients {
+		return fmt.Errorf("multi send max recipients must not exceed %d", MaxMultiSendMaxRecipients)
+	}
+	if p.MultiSendGasFactor > MaxMultiSendGasFactor {
+		return fmt.Errorf("multi send gas factor must not exceed %d", MaxMultiSendGasFactor)
+	}
+	if p.BatchSendMaxRecipients == 0 {
+		return fmt.Errorf("batch send max recipients must be positive")
+	}
+	if p.BatchSendMaxRecipients > MaxBatchSendMaxRecipients {
+		return fmt.Errorf("batch send max recipients must not exceed %d", MaxBatchSendMaxRecipients)
+	}
+	if p.BatchSendGasPerRecipient > MaxBatchSendGasPerRecipient {
+		return fmt.Errorf("batch send gas per recipient must not exceed %d", MaxBatchSendGasPerRecipient)
+	}
+	if p.FanoutWindowBlocks == 0 {
+		return fmt.Errorf("fanout window blocks must be positive")
+	}
+	return nil
+}
+
+// FanoutBudgetEnabled returns true if accounts have a fan-out budget.
+func (p Params) FanoutBudgetEnabled() bool {
+	return p.FanoutBudget > 0
+}
+
+// FanoutWindow returns the index of the fan-out budget window of height.
+func (p Params) FanoutWindow(height int64) uint64 {
+	return uint64(height) / p.FanoutWindowBlocks
+}
+
+// MultiSendGasSurcharge returns the quadratic gas surcharge of a MsgMultiSend
+// performing fanout transfers, MultiSendGasFactor * fanout^2.
+func (p Params) MultiSendGasSurcharge(fanout uint64) (uint64, error) {
+	return mulGas(p.MultiSendGasFactor, fanout, fanout)
+}
+
+// BatchSendGas returns the linear gas charged for a MsgBatchSend of transfers
+// transfers, BatchSendGasPerRecipient * transfers.
+func (p Params) BatchSendGas(transfers uint64) (uint64, error) {
+	return mulGas(p.BatchSendGasPerRecipient, transfers)
+}
+
+// mulGas returns the product of the gas amounts, or ErrGasOverflow if it does
+// not fit in a uint64.
+func mulGas(amounts ...uint64) (uint64, error) {
+	product := uint64(1)
+	for _, amount := range amounts {
+		hi, lo := bits.Mul64(product, amount)
+		if hi != 0 {
+			return 0, errorsmod.Wrapf(ErrGasOverflow, "%v", amounts)
+		}
+		product = lo
+	}
+	return product, nil
+}
@@ -0,0 +1,54 @@
+package feedenoms
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the accepted fee denoms",
+					Example:   fmt.Sprintf("$ %s query feedenoms params", version.AppName),
+				},
+				{
+					RpcMethod: "Rates",
+					Use:       "rates",
+					Short:     "Query the current conversion rates of the fee denoms",
+					Example:   fmt.Sprintf("$ %s query feedenoms rates", version.AppName),
+				},
+				{
+					RpcMethod:      "Rate",
+					Use:            "rate [denom]",
+					Short:          "Query the current conversion rate of a fee denom",
+					Example:        fmt.Sprintf("$ %s query feedenoms rate ibc/F663521BF1836B00F5F177680F74BFB9A8B5654A694D0D2BC249E03CF2509013", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "denom"}},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service:              types.Msg_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "PushRates",
+					Skip:      true, // custom command, see cli.NewPushRatesCmd
+				},
+			},
+		},
+	}
+}
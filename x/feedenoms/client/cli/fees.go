@@ -0,0 +1,183 @@
+package cli
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/estimate"
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+// FeesAuto is the --fees value requesting the fee to be estimated by the
+// node. The fee is paid in the feemarket fee denom, unless another accepted
+// denom is given as "auto:<denom>".
+const FeesAuto = "auto"
+
+// estimateFn estimates the fee of an encoded tx.
+type estimateFn func(txBytes []byte) (*types.EstimateFeeResponse, error)
+
+// ReadAutoFeeFlag returns clientCtx with a TxConfig setting the fee, and the
+// gas limit unless --gas is set, of the tx it builds from the EstimateFee
+// service of the node, if --fees is set to auto. The --fees flag is cleared
+// so that the tx factory does not parse it. As the fee is estimated when the
+// tx is signed, --fees auto cannot be used with --generate-only.
+// clientCtx is returned unchanged otherwise.
+func ReadAutoFeeFlag(clientCtx client.Context, flagSet *pflag.FlagSet) (client.Context, error) {
+	feesFlag := flagSet.Lookup(flags.FlagFees)
+	if feesFlag == nil {
+		return clientCtx, nil
+	}
+	denom, ok := strings.CutPrefix(feesFlag.Value.String(), FeesAuto)
+	if !ok {
+		return clientCtx, nil
+	}
+	switch {
+	case denom == "":
+	case strings.HasPrefix(denom, ":"):
+		denom = denom[1:]
+		if err := sdk.ValidateDenom(denom); err != nil {
+			return clientCtx, fmt.Errorf("invalid --%s: %w", flags.FlagFees, err)
+		}
+	default:
+		// a denom starting with auto
+		return clientCtx, nil
+	}
+
+	if gasPrices, _ := flagSet.GetString(flags.FlagGasPrices); gasPrices != "" {
+		return clientCtx, fmt.Errorf("--%s=%s and --%s are mutually exclusive", flags.FlagFees, FeesAuto, flags.FlagGasPrices)
+	}
+	if clientCtx.Offline {
+		return clientCtx, fmt.Errorf("--%s=%s requires a node", flags.FlagFees, FeesAuto)
+	}
+	if generateOnly, _ := flagSet.GetBool(flags.FlagGenerateOnly); generateOnly {
+		return clientCtx, fmt.Errorf("--%s=%s and --%s are mutually exclusive", flags.FlagFees, FeesAuto, flags.FlagGenerateOnly)
+	}
+	if clientCtx.TxConfig == nil {
+		return clientCtx, errors.New("tx config is not set")
+	}
+	if err := flagSet.Set(flags.FlagFees, ""); err != nil {
+		return clientCtx, err
+	}
+
+	gasAdjustment, _ := flagSet.GetFloat64(flags.FlagGasAdjustment)
+	queryClient := types.NewServiceClient(clientCtx)
+	estimateFee := func(txBytes []byte) (*types.EstimateFeeResponse, error) {
+		return queryClient.EstimateFee(context.Background(), &types.EstimateFeeRequest{
+			TxBytes:       txBytes,
+			GasAdjustment: gasAdjustment,
+		})
+	}
+
+	return clientCtx.WithTxConfig(autoFeeTxConfig{
+		TxConfig: clientCtx.TxConfig,
+		estimate: estimateFee,
+		denom:    denom,
+		// a set --gas, including auto, is kept and only priced
+		fixedGas: flagSet.Changed(flags.FlagGas),
+		result:   &feeEstimate{},
+	}), nil
+}
+
+// autoFeeTxConfig sets the estimated fee of every new tx builder.
+type autoFeeTxConfig struct {
+	client.TxConfig
+
+	estimate estimateFn
+	denom    string
+	fixedGas bool
+	// result is shared by the tx builders of a command, so that the fee is
+	// estimated once even when the gas is simulated first
+	result *feeEstimate
+}
+
+// feeEstimate is the gas limit and gas price estimated for a tx.
+type feeEstimate struct {
+	done     bool
+	err      error
+	gasLimit uint64
+	gasPrice sdk.DecCoin
+}
+
+func (c autoFeeTxConfig) NewTxBuilder() client.TxBuilder {
+	builder := c.TxConfig.NewTxBuilder()
+	extBuilder, ok := builder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return builder
+	}
+	return &autoFeeTxBuilder{ExtensionOptionsTxBuilder: extBuilder, config: c}
+}
+
+// autoFeeTxBuilder sets the estimated fee of its tx when its signatures are
+// set, which the tx factory does once the tx is complete, before computing
+// the sign bytes or simulating it, and where it handles errors. The fee is
+// estimated from the encoded tx, including its memo, extension options and
+// signer infos.
+type autoFeeTxBuilder struct {
+	authtx.ExtensionOptionsTxBuilder
+
+	config autoFeeTxConfig
+}
+
+func (b *autoFeeTxBuilder) SetSignatures(signatures ...signing.SignatureV2) error {
+	if err := b.ExtensionOptionsTxBuilder.SetSignatures(signatures...); err != nil {
+		return err
+	}
+	result := b.config.result
+	if !result.done {
+		result.gasLimit, result.gasPrice, result.err = b.estimateFee()
+		result.done = true
+	}
+	if result.err != nil {
+		return result.err
+	}
+
+	gasLimit := result.gasLimit
+	if b.config.fixedGas {
+		gasLimit = b.GetTx().GetGas()
+	}
+	b.SetGasLimit(gasLimit)
+	b.SetFeeAmount(estimate.Fees(sdk.DecCoins{result.gasPrice}, gasLimit))
+	return nil
+}
+
+// estimateFee returns the estimated gas limit of the tx and its gas price in
+// the fee denom.
+func (b *autoFeeTxBuilder) estimateFee() (uint64, sdk.DecCoin, error) {
+	txBytes, err := b.config.TxEncoder()(b.GetTx())
+	if err != nil {
+		return 0, sdk.DecCoin{}, err
+	}
+	resp, err := b.config.estimate(txBytes)
+	if err != nil {
+		return 0, sdk.DecCoin{}, fmt.Errorf("failed to estimate fee: %w", err)
+	}
+	denom := b.config.denom
+	if denom == "" {
+		denom = resp.FeeDenom
+	}
+	gasPrice, found := findGasPrice(resp.GasPrices, denom)
+	if !found {
+		return 0, sdk.DecCoin{}, fmt.Errorf("fee denom %s is not accepted, gas prices: %s", denom, resp.GasPrices)
+	}
+	return resp.GasLimit, gasPrice, nil
+}
+
+// findGasPrice returns the gas price of denom.
+func findGasPrice(gasPrices sdk.DecCoins, denom string) (sdk.DecCoin, bool) {
+	for _, gasPrice := range gasPrices {
+		if gasPrice.Denom == denom {
+			return gasPrice, true
+		}
+	}
+	return sdk.DecCoin{}, false
+}
@@ -0,0 +1,158 @@
+package cli
+
+import (
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+func TestReadAutoFeeFlag(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	clientCtx := client.Context{}.WithTxConfig(authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes))
+
+	testCases := []struct {
+		name     string
+		args     []string
+		offline  bool
+		expAuto  bool
+		expDenom string
+		expErr   string
+	}{
+		{name: "no fees"},
+		{name: "fees", args: []string{"--fees=10uatom"}},
+		{name: "denom starting with auto", args: []string{"--fees=10autoken"}},
+		{name: "auto", args: []string{"--fees=auto"}, expAuto: true},
+		{name: "auto with denom", args: []string{"--fees=auto:ibc/usdc"}, expAuto: true, expDenom: "ibc/usdc"},
+		{name: "auto with invalid denom", args: []string{"--fees=auto:1"}, expErr: "invalid --fees"},
+		{name: "auto with gas prices", args: []string{"--fees=auto", "--gas-prices=1uatom"}, expErr: "mutually exclusive"},
+		{name: "auto offline", args: []string{"--fees=auto"}, offline: true, expErr: "requires a node"},
+		{name: "auto with generate only", args: []string{"--fees=auto", "--generate-only"}, expErr: "mutually exclusive"},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			flags.AddTxFlagsToCmd(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			ctx, err := ReadAutoFeeFlag(clientCtx.WithOffline(tc.offline), cmd.Flags())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			txConfig, ok := ctx.TxConfig.(autoFeeTxConfig)
+			require.Equal(t, tc.expAuto, ok)
+			if tc.expAuto {
+				require.Equal(t, tc.expDenom, txConfig.denom)
+				fees, _ := cmd.Flags().GetString(flags.FlagFees)
+				require.Empty(t, fees)
+			}
+		})
+	}
+}
+
+func TestAutoFeeTxBuilder(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	banktypes.RegisterInterfaces(registry)
+	txConfig := authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes)
+
+	resp := &types.EstimateFeeResponse{
+		GasUsed:  80_000,
+		GasLimit: 100_000,
+		GasPrices: sdk.NewDecCoins(
+			sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.005")),
+			sdk.NewDecCoinFromDec("ibc/usdc", math.LegacyMustNewDecFromStr("0.0001")),
+		),
+		FeeDenom: "uatom",
+	}
+	var estimated [][]byte
+	estimateFee := func(txBytes []byte) (*types.EstimateFeeResponse, error) {
+		estimated = append(estimated, txBytes)
+		return resp, nil
+	}
+
+	testCases := []struct {
+		name     string
+		denom    string
+		fixedGas bool
+		expGas   uint64
+		expFees  sdk.Coins
+		expErr   string
+	}{
+		{
+			name:    "fee denom",
+			expGas:  100_000,
+			expFees: sdk.NewCoins(sdk.NewInt64Coin("uatom", 500)),
+		},
+		{
+			name:    "other denom",
+			denom:   "ibc/usdc",
+			expGas:  100_000,
+			expFees: sdk.NewCoins(sdk.NewInt64Coin("ibc/usdc", 10)),
+		},
+		{
+			name:     "fixed gas",
+			fixedGas: true,
+			expGas:   300_000,
+			expFees:  sdk.NewCoins(sdk.NewInt64Coin("uatom", 1500)),
+		},
+		{
+			name:   "unaccepted denom",
+			denom:  "ibc/osmo",
+			expErr: "fee denom ibc/osmo is not accepted",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			estimated = nil
+			config := autoFeeTxConfig{
+				TxConfig: txConfig,
+				estimate: estimateFee,
+				denom:    tc.denom,
+				fixedGas: tc.fixedGas,
+				result:   &feeEstimate{},
+			}
+			txf := clienttx.Factory{}.WithTxConfig(config).WithChainID("test").WithGas(300_000).WithMemo("memo")
+
+			// the fee is estimated once, from the complete tx, when the
+			// signatures of the first tx are set
+			for i := 0; i < 2; i++ {
+				builder, err := txf.BuildUnsignedTx(&banktypes.MsgSend{})
+				require.NoError(t, err)
+				err = builder.SetSignatures()
+				if tc.expErr != "" {
+					require.ErrorContains(t, err, tc.expErr)
+					return
+				}
+				require.NoError(t, err)
+
+				tx := builder.GetTx()
+				require.Equal(t, tc.expGas, tx.GetGas())
+				require.Equal(t, tc.expFees, tx.GetFee())
+			}
+
+			require.Len(t, estimated, 1)
+			tx, err := txConfig.TxDecoder()(estimated[0])
+			require.NoError(t, err)
+			require.Equal(t, "memo", tx.(sdk.TxWithMemo).GetMemo())
+		})
+	}
+}
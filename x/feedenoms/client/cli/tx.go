@@ -0,0 +1,82 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+// NewTxCmd returns a root CLI command handler for the x/feedenoms transaction
+// commands that are not generated by autocli.
+func NewTxCmd() *cobra.Command {
+	feedenomsTxCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Fee denoms transaction subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	feedenomsTxCmd.AddCommand(
+		NewPushRatesCmd(),
+	)
+
+	return feedenomsTxCmd
+}
+
+// NewPushRatesCmd defines a command for the rate authority to push the
+// conversion rates of fee denoms.
+func NewPushRatesCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "push-rates [rates]",
+		Short: "Push the conversion rates of fee denoms, as the rate authority",
+		Args:  cobra.ExactArgs(1),
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Push the conversion rates of fee denoms, as the rate authority.
+
+The rates are given as decimal coins: the rate of a denom is the amount of the
+denom worth one unit of the feemarket fee denom.
+
+Example:
+$ %s tx feedenoms push-rates 4.5ibc/F663521BF1836B00F5F177680F74BFB9A8B5654A694D0D2BC249E03CF2509013,0.8uosmo --from rate-authority
+`,
+				version.AppName,
+			),
+		),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			coins, err := sdk.ParseDecCoins(args[0])
+			if err != nil {
+				return err
+			}
+			rates := make([]types.DenomRate, 0, len(coins))
+			for _, coin := range coins {
+				rates = append(rates, types.DenomRate{Denom: coin.Denom, Rate: coin.Amount})
+			}
+
+			msg := &types.MsgPushRates{
+				Authority: clientCtx.GetFromAddress().String(),
+				Rates:     rates,
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -0,0 +1,255 @@
+// Package estimate implements the fee estimation service of x/feedenoms,
+// which simulates transactions and prices them at the current feemarket gas
+// price in each accepted fee denom.
+package estimate
+
+import (
+	"context"
+	"math"
+	"math/bits"
+
+	gogogrpc "github.com/cosmos/gogoproto/grpc"
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	gaiabank "github.com/cosmos/gaia/v29/x/bank"
+	gaiabanktypes "github.com/cosmos/gaia/v29/x/bank/types"
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+// MaxGasAdjustment is the largest gas adjustment accepted by EstimateFee.
+const MaxGasAdjustment = 100
+
+// SimulateFn is the signature of the Baseapp#Simulate function.
+type SimulateFn func(txBytes []byte) (sdk.GasInfo, *sdk.Result, error)
+
+// AccountKeeper provides the accounts of the signers of simulated messages.
+type AccountKeeper interface {
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+}
+
+// FeeMarketKeeper provides the feemarket gas prices.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) (feemarkettypes.Params, error)
+	GetMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error)
+}
+
+// BankKeeper provides the MultiSend params of the gaiabank module.
+type BankKeeper interface {
+	GetParams(ctx context.Context) (gaiabanktypes.Params, error)
+}
+
+// Server implements the fee estimation service.
+type Server struct {
+	cdc             codec.Codec
+	txConfig        client.TxConfig
+	simulate        SimulateFn
+	accountKeeper   AccountKeeper
+	feemarketKeeper FeeMarketKeeper
+	bankKeeper      BankKeeper
+}
+
+var _ types.ServiceServer = Server{}
+
+// NewServer creates a new fee estimation server.
+func NewServer(
+	cdc codec.Codec,
+	txConfig client.TxConfig,
+	simulate SimulateFn,
+	accountKeeper AccountKeeper,
+	feemarketKeeper FeeMarketKeeper,
+	bankKeeper BankKeeper,
+) Server {
+	return Server{
+		cdc:             cdc,
+		txConfig:        txConfig,
+		simulate:        simulate,
+		accountKeeper:   accountKeeper,
+		feemarketKeeper: feemarketKeeper,
+		bankKeeper:      bankKeeper,
+	}
+}
+
+// RegisterService registers the fee estimation service on the gRPC router.
+func RegisterService(qrt gogogrpc.Server, server Server) {
+	types.RegisterServiceServer(qrt, server)
+}
+
+// RegisterGRPCGatewayRoutes mounts the fee estimation service's gRPC-gateway
+// routes on the given mux.
+func RegisterGRPCGatewayRoutes(clientConn gogogrpc.ClientConn, mux *runtime.ServeMux) {
+	_ = types.RegisterServiceHandlerClient(context.Background(), mux, types.NewServiceClient(clientConn))
+}
+
+// EstimateFee implements the Service/EstimateFee RPC method.
+func (s Server) EstimateFee(goCtx context.Context, req *types.EstimateFeeRequest) (*types.EstimateFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if math.IsNaN(req.GasAdjustment) || math.IsInf(req.GasAdjustment, 0) || req.GasAdjustment > MaxGasAdjustment {
+		return nil, status.Errorf(codes.InvalidArgument, "gas adjustment must be a finite number not above %d", MaxGasAdjustment)
+	}
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	var (
+		txBytes = req.TxBytes
+		msgs    []sdk.Msg
+	)
+	switch {
+	case len(req.TxBytes) > 0 && len(req.Msgs) > 0:
+		return nil, status.Error(codes.InvalidArgument, "tx_bytes and msgs are mutually exclusive")
+	case len(req.TxBytes) > 0:
+		tx, err := s.txConfig.TxDecoder()(req.TxBytes)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid tx: %s", err)
+		}
+		msgs = tx.GetMsgs()
+	case len(req.Msgs) > 0:
+		msgs = make([]sdk.Msg, len(req.Msgs))
+		for i, msgAny := range req.Msgs {
+			if err := s.cdc.UnpackAny(msgAny, &msgs[i]); err != nil {
+				return nil, status.Errorf(codes.InvalidArgument, "invalid msg %d: %s", i, err)
+			}
+		}
+		var err error
+		if txBytes, err = s.buildTx(ctx, msgs); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "cannot build tx: %s", err)
+		}
+	default:
+		return nil, status.Error(codes.InvalidArgument, "tx_bytes or msgs is required")
+	}
+
+	gasInfo, _, err := s.simulate(txBytes)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "simulation failed: %s", err)
+	}
+	adjustedGas := math.Ceil(float64(gasInfo.GasUsed) * math.Max(req.GasAdjustment, 1))
+	// 2^64 is the smallest float64 above math.MaxUint64
+	if adjustedGas >= math.Exp2(64) {
+		return nil, status.Errorf(codes.InvalidArgument, "adjusted gas overflows: %d * %g", gasInfo.GasUsed, req.GasAdjustment)
+	}
+	gasLimit := uint64(adjustedGas)
+
+	surcharge, err := s.multiSendSurcharge(ctx, msgs)
+	if err != nil {
+		return nil, err
+	}
+
+	params, err := s.feemarketKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	// without the feemarket, the fallback fee checker of the ante handler
+	// only accepts the fee denom at the min base gas price
+	gasPrices := sdk.NewDecCoins(sdk.NewDecCoinFromDec(params.FeeDenom, params.MinBaseGasPrice))
+	if params.Enabled {
+		if gasPrices, err = s.feemarketKeeper.GetMinGasPrices(ctx); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.EstimateFeeResponse{
+		GasUsed:               gasInfo.GasUsed,
+		GasLimit:              gasLimit,
+		MultisendSurchargeGas: surcharge,
+		GasPrices:             gasPrices,
+		Fees:                  Fees(gasPrices, gasLimit),
+		FeeDenom:              params.FeeDenom,
+	}, nil
+}
+
+// Fees returns the fees of a tx with the given gas limit, at the given gas
+// prices, rounded up.
+func Fees(gasPrices sdk.DecCoins, gasLimit uint64) sdk.Coins {
+	fees := make(sdk.Coins, 0, len(gasPrices))
+	for _, gasPrice := range gasPrices {
+		amount := gasPrice.Amount.MulInt(sdkmath.NewIntFromUint64(gasLimit)).Ceil().RoundInt()
+		fees = append(fees, sdk.NewCoin(gasPrice.Denom, amount))
+	}
+	return fees
+}
+
+// buildTx encodes an unsigned tx of msgs, with the signer infos of the
+// current accounts of their signers.
+func (s Server) buildTx(ctx sdk.Context, msgs []sdk.Msg) ([]byte, error) {
+	builder := s.txConfig.NewTxBuilder()
+	if err := builder.SetMsgs(msgs...); err != nil {
+		return nil, err
+	}
+
+	var (
+		sigs []signing.SignatureV2
+		seen = make(map[string]struct{})
+	)
+	for _, msg := range msgs {
+		signers, _, err := s.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, err
+		}
+		for _, signer := range signers {
+			if _, ok := seen[string(signer)]; ok {
+				continue
+			}
+			seen[string(signer)] = struct{}{}
+
+			// the ante handler uses a sentinel key for signers without one,
+			// and multisig keys would need a signature per sub-key
+			var (
+				pubKey   cryptotypes.PubKey = &secp256k1.PubKey{}
+				sequence uint64
+			)
+			if acc := s.accountKeeper.GetAccount(ctx, signer); acc != nil {
+				sequence = acc.GetSequence()
+				if pk, ok := acc.GetPubKey().(*secp256k1.PubKey); ok {
+					pubKey = pk
+				}
+			}
+			sigs = append(sigs, signing.SignatureV2{
+				PubKey:   pubKey,
+				Data:     &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+				Sequence: sequence,
+			})
+		}
+	}
+	if err := builder.SetSignatures(sigs...); err != nil {
+		return nil, err
+	}
+
+	return s.txConfig.TxEncoder()(builder.GetTx())
+}
+
+// multiSendSurcharge returns the MultiSend quadratic gas surcharge of msgs,
+// computed as the gaiabank MsgMultiSend wrapper charges it.
+func (s Server) multiSendSurcharge(ctx sdk.Context, msgs []sdk.Msg) (uint64, error) {
+	params, err := s.bankKeeper.GetParams(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	var surcharge uint64
+	for _, msg := range msgs {
+		if multiSend, ok := msg.(*banktypes.MsgMultiSend); ok {
+			msgSurcharge, err := params.MultiSendGasSurcharge(gaiabank.MultiSendFanout(multiSend))
+			if err != nil {
+				return 0, err
+			}
+			var carry uint64
+			if surcharge, carry = bits.Add64(surcharge, msgSurcharge, 0); carry != 0 {
+				return 0, gaiabanktypes.ErrGasOverflow
+			}
+		}
+	}
+	return surcharge, nil
+}
@@ -0,0 +1,139 @@
+package estimate_test
+
+import (
+	stdmath "math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/feedenoms/estimate"
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+func TestEstimateFee(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	_, err := gaiaApp.Commit()
+	require.NoError(t, err)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: gaiaApp.LastBlockHeight()})
+	server := estimate.NewServer(
+		gaiaApp.AppCodec(),
+		gaiaApp.GetTxConfig(),
+		gaiaApp.Simulate,
+		gaiaApp.AccountKeeper,
+		gaiaApp.FeeMarketKeeper,
+		gaiaApp.GaiaBankKeeper,
+	)
+
+	// the genesis account of the test app
+	var senderAcc sdk.AccountI
+	gaiaApp.AccountKeeper.IterateAccounts(ctx, func(acc sdk.AccountI) bool {
+		if acc.GetPubKey() != nil {
+			senderAcc = acc
+			return true
+		}
+		return false
+	})
+	require.NotNil(t, senderAcc)
+	sender := senderAcc.GetAddress()
+
+	feemarketParams, err := gaiaApp.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	bankParams, err := gaiaApp.GaiaBankKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	feeDenom := feemarketParams.FeeDenom
+	amount := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 3))
+
+	multiSend := &banktypes.MsgMultiSend{
+		Inputs: []banktypes.Input{banktypes.NewInput(sender, amount)},
+		Outputs: []banktypes.Output{
+			banktypes.NewOutput(sdk.AccAddress("recipient-1"), sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1))),
+			banktypes.NewOutput(sdk.AccAddress("recipient-2"), sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1))),
+			banktypes.NewOutput(sdk.AccAddress("recipient-3"), sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 1))),
+		},
+	}
+	msgAny, err := codectypes.NewAnyWithValue(multiSend)
+	require.NoError(t, err)
+
+	_, err = server.EstimateFee(ctx, &types.EstimateFeeRequest{})
+	require.ErrorContains(t, err, "tx_bytes or msgs is required")
+	_, err = server.EstimateFee(ctx, &types.EstimateFeeRequest{TxBytes: []byte{1}, Msgs: []*codectypes.Any{msgAny}})
+	require.ErrorContains(t, err, "mutually exclusive")
+	for _, gasAdjustment := range []float64{stdmath.NaN(), stdmath.Inf(1), stdmath.Inf(-1), estimate.MaxGasAdjustment + 0.1} {
+		_, err = server.EstimateFee(ctx, &types.EstimateFeeRequest{Msgs: []*codectypes.Any{msgAny}, GasAdjustment: gasAdjustment})
+		require.Equal(t, codes.InvalidArgument, status.Code(err), gasAdjustment)
+	}
+
+	resp, err := server.EstimateFee(ctx, &types.EstimateFeeRequest{Msgs: []*codectypes.Any{msgAny}, GasAdjustment: 1.5})
+	require.NoError(t, err)
+	require.Equal(t, bankParams.MultiSendGasFactor*9, resp.MultisendSurchargeGas)
+	// the surcharge is consumed by the simulated tx
+	require.Greater(t, resp.GasUsed, resp.MultisendSurchargeGas)
+	require.Equal(t, uint64(float64(resp.GasUsed)*1.5+0.5), resp.GasLimit)
+	require.Equal(t, feeDenom, resp.FeeDenom)
+	gasPrices, err := gaiaApp.FeeMarketKeeper.GetMinGasPrices(ctx)
+	require.NoError(t, err)
+	require.Equal(t, gasPrices, resp.GasPrices)
+	require.Equal(t, estimate.Fees(gasPrices, resp.GasLimit), resp.Fees)
+
+	// the same tx, encoded
+	builder := gaiaApp.GetTxConfig().NewTxBuilder()
+	require.NoError(t, builder.SetMsgs(multiSend))
+	require.NoError(t, builder.SetSignatures(signing.SignatureV2{
+		PubKey: senderAcc.GetPubKey(),
+		Data:   &signing.SingleSignatureData{SignMode: signing.SignMode_SIGN_MODE_DIRECT},
+	}))
+	txBytes, err := gaiaApp.GetTxConfig().TxEncoder()(builder.GetTx())
+	require.NoError(t, err)
+	txResp, err := server.EstimateFee(ctx, &types.EstimateFeeRequest{TxBytes: txBytes})
+	require.NoError(t, err)
+	require.Equal(t, resp.MultisendSurchargeGas, txResp.MultisendSurchargeGas)
+	require.Equal(t, txResp.GasUsed, txResp.GasLimit)
+
+	// the adjusted gas must fit in a gas limit
+	overflowServer := estimate.NewServer(
+		gaiaApp.AppCodec(),
+		gaiaApp.GetTxConfig(),
+		func([]byte) (sdk.GasInfo, *sdk.Result, error) {
+			return sdk.GasInfo{GasUsed: stdmath.MaxUint64 / 2}, &sdk.Result{}, nil
+		},
+		gaiaApp.AccountKeeper,
+		gaiaApp.FeeMarketKeeper,
+		gaiaApp.GaiaBankKeeper,
+	)
+	_, err = overflowServer.EstimateFee(ctx, &types.EstimateFeeRequest{TxBytes: txBytes, GasAdjustment: 2.5})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+	require.ErrorContains(t, err, "overflows")
+	txResp, err = overflowServer.EstimateFee(ctx, &types.EstimateFeeRequest{TxBytes: txBytes})
+	require.NoError(t, err)
+	require.Equal(t, uint64(stdmath.MaxUint64/2+1), txResp.GasLimit)
+
+	// without the feemarket, only the fee denom is accepted at the min base
+	// gas price
+	feemarketParams.Enabled = false
+	feemarketParams.MinBaseGasPrice = math.LegacyMustNewDecFromStr("0.005")
+	require.NoError(t, gaiaApp.FeeMarketKeeper.SetParams(ctx, feemarketParams))
+	resp, err = server.EstimateFee(ctx, &types.EstimateFeeRequest{Msgs: []*codectypes.Any{msgAny}})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoins(sdk.NewDecCoinFromDec(feeDenom, feemarketParams.MinBaseGasPrice)), resp.GasPrices)
+	require.Equal(t, sdk.NewCoins(sdk.NewCoin(feeDenom, math.LegacyNewDecWithPrec(5, 3).MulInt64(int64(resp.GasLimit)).Ceil().RoundInt())), resp.Fees)
+}
+
+func TestFees(t *testing.T) {
+	gasPrices := sdk.NewDecCoins(
+		sdk.NewDecCoinFromDec("uatom", math.LegacyMustNewDecFromStr("0.005")),
+		sdk.NewDecCoinFromDec("uusdc", math.LegacyMustNewDecFromStr("0.0001")),
+	)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uatom", 501), sdk.NewInt64Coin("uusdc", 11)), estimate.Fees(gasPrices, 100_001))
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+// InitGenesis sets feedenoms information for genesis
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+	for _, rate := range data.PushedRates {
+		if err := k.SetPushedRate(ctx, rate); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	pushedRates, err := k.GetAllPushedRates(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, pushedRates)
+}
@@ -0,0 +1,60 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries the feedenoms parameters
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Rates queries the current rates of the fee denoms
+func (k Querier) Rates(ctx context.Context, _ *types.QueryRatesRequest) (*types.QueryRatesResponse, error) {
+	rates, err := k.GetCurrentRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRatesResponse{Rates: rates}, nil
+}
+
+// Rate queries the current rate of a fee denom
+func (k Querier) Rate(ctx context.Context, req *types.QueryRateRequest) (*types.QueryRateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	feeDenom, ok := params.GetFeeDenom(req.Denom)
+	if !ok {
+		return nil, status.Errorf(codes.NotFound, "%s is not accepted to pay fees", req.Denom)
+	}
+	rate, err := k.GetCurrentRate(ctx, params, feeDenom)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRateResponse{Rate: rate}, nil
+}
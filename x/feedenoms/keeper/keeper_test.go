@@ -0,0 +1,116 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/feedenoms/keeper"
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+const (
+	usdc = "ibc/usdc"
+	osmo = "ibc/osmo"
+)
+
+func TestFeeDenoms(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	k := gaiaApp.FeeDenomsKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	querier := keeper.NewQuerier(k)
+	authority := k.GetAuthority()
+	rateAuthority := sdk.AccAddress("rate-authority").String()
+
+	feemarketParams, err := gaiaApp.FeeMarketKeeper.GetParams(ctx)
+	require.NoError(t, err)
+	feeDenom := feemarketParams.FeeDenom
+
+	// only the fee denom is accepted by default
+	_, err = gaiaApp.FeeMarketKeeper.GetMinGasPrice(ctx, usdc)
+	require.ErrorIs(t, err, types.ErrUnsupportedDenom)
+
+	params := types.NewParams(rateAuthority, 5,
+		types.FeeDenom{Denom: usdc, Rate: math.LegacyNewDec(4)},
+		types.FeeDenom{Denom: osmo, Rate: math.LegacyZeroDec()},
+	)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: rateAuthority, Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams("", 0, types.FeeDenom{Denom: feeDenom, Rate: math.LegacyOneDec()}),
+	})
+	require.ErrorIs(t, err, govtypes.ErrInvalidProposalMsg)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	// the static rate of usdc is used, osmo has no rate yet
+	extraDenoms, err := k.ExtraDenoms(ctx)
+	require.NoError(t, err)
+	require.Equal(t, []string{usdc}, extraDenoms)
+
+	baseGasPrice, err := gaiaApp.FeeMarketKeeper.GetMinGasPrice(ctx, feeDenom)
+	require.NoError(t, err)
+	gasPrice, err := gaiaApp.FeeMarketKeeper.GetMinGasPrice(ctx, usdc)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoinFromDec(usdc, baseGasPrice.Amount.MulInt64(4)), gasPrice)
+	_, err = gaiaApp.FeeMarketKeeper.GetMinGasPrice(ctx, osmo)
+	require.ErrorIs(t, err, types.ErrRateUnavailable)
+
+	// only the rate authority may push rates of accepted denoms
+	_, err = msgServer.PushRates(ctx, &types.MsgPushRates{Authority: authority, Rates: []types.DenomRate{{Denom: osmo, Rate: math.LegacyNewDec(2)}}})
+	require.ErrorIs(t, err, types.ErrUnauthorized)
+	_, err = msgServer.PushRates(ctx, &types.MsgPushRates{Authority: rateAuthority, Rates: []types.DenomRate{{Denom: "uother", Rate: math.LegacyNewDec(2)}}})
+	require.ErrorIs(t, err, types.ErrUnsupportedDenom)
+	_, err = msgServer.PushRates(ctx, &types.MsgPushRates{Authority: rateAuthority, Rates: []types.DenomRate{{Denom: osmo, Rate: math.LegacyZeroDec()}}})
+	require.ErrorIs(t, err, types.ErrInvalidRate)
+	_, err = msgServer.PushRates(ctx, &types.MsgPushRates{Authority: rateAuthority, Rates: []types.DenomRate{
+		{Denom: usdc, Rate: math.LegacyNewDec(5)},
+		{Denom: osmo, Rate: math.LegacyNewDec(2)},
+	}})
+	require.NoError(t, err)
+
+	// pushed rates take precedence over static rates
+	resp, err := querier.Rates(ctx, &types.QueryRatesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.CurrentRate{
+		{Denom: usdc, Rate: math.LegacyNewDec(5), Source: types.RateSourcePushed, Height: 10},
+		{Denom: osmo, Rate: math.LegacyNewDec(2), Source: types.RateSourcePushed, Height: 10},
+	}, resp.Rates)
+
+	// conversions between two fee denoms go through the fee denom
+	converted, err := k.ConvertToDenom(ctx, sdk.NewDecCoin(usdc, math.NewInt(10)), osmo)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoin(osmo, math.NewInt(4)), converted)
+	converted, err = k.ConvertToDenom(ctx, sdk.NewDecCoin(usdc, math.NewInt(10)), feeDenom)
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewDecCoin(feeDenom, math.NewInt(2)), converted)
+
+	// pushed rates expire after max_rate_age blocks
+	ctx = ctx.WithBlockHeight(16)
+	rate, err := querier.Rate(ctx, &types.QueryRateRequest{Denom: usdc})
+	require.NoError(t, err)
+	require.Equal(t, types.CurrentRate{Denom: usdc, Rate: math.LegacyNewDec(4), Source: types.RateSourceStatic}, rate.Rate)
+	rate, err = querier.Rate(ctx, &types.QueryRateRequest{Denom: osmo})
+	require.NoError(t, err)
+	require.Equal(t, types.RateSourceUnspecified, rate.Rate.Source)
+	_, err = querier.Rate(ctx, &types.QueryRateRequest{Denom: "uother"})
+	require.Error(t, err)
+
+	// the pushed rates of denoms no longer accepted are deleted
+	params.FeeDenoms = params.FeeDenoms[:1]
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+	genesis := k.ExportGenesis(ctx)
+	require.Equal(t, []types.PushedRate{{Denom: usdc, Rate: math.LegacyNewDec(5), Height: 10}}, genesis.PushedRates)
+	require.NoError(t, types.ValidateGenesis(genesis))
+}
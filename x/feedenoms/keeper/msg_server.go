@@ -0,0 +1,91 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the feedenoms MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+// UpdateParams defines a method to perform updating of params for the x/feedenoms module.
+// The pushed rates of the denoms which are no longer accepted are deleted.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if k.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	feemarketParams, err := k.feemarketKeeper.GetParams(sdk.UnwrapSDKContext(ctx))
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := msg.Params.GetFeeDenom(feemarketParams.FeeDenom); ok {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidProposalMsg, "%s is the feemarket fee denom", feemarketParams.FeeDenom)
+	}
+
+	pushedRates, err := k.GetAllPushedRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, rate := range pushedRates {
+		if _, ok := msg.Params.GetFeeDenom(rate.Denom); ok {
+			continue
+		}
+		if err := k.DeletePushedRate(ctx, rate.Denom); err != nil {
+			return nil, err
+		}
+	}
+
+	// store params
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// PushRates defines a method for the rate authority to push the conversion
+// rates of accepted fee denoms.
+func (k msgServer) PushRates(ctx context.Context, msg *types.MsgPushRates) (*types.MsgPushRatesResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if params.RateAuthority == "" || params.RateAuthority != msg.Authority {
+		return nil, errorsmod.Wrapf(types.ErrUnauthorized, "expected %q, got %s", params.RateAuthority, msg.Authority)
+	}
+
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	for _, rate := range msg.Rates {
+		if _, ok := params.GetFeeDenom(rate.Denom); !ok {
+			return nil, errorsmod.Wrap(types.ErrUnsupportedDenom, rate.Denom)
+		}
+		if rate.Rate.IsNil() || !rate.Rate.IsPositive() {
+			return nil, errorsmod.Wrapf(types.ErrInvalidRate, "rate of %s must be positive", rate.Denom)
+		}
+		if err := k.SetPushedRate(ctx, types.PushedRate{Denom: rate.Denom, Rate: rate.Rate, Height: height}); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgPushRatesResponse{}, nil
+}
@@ -0,0 +1,131 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+// SetPushedRate stores a rate pushed by the rate authority, replacing the
+// previous rate of its denom.
+func (k Keeper) SetPushedRate(ctx context.Context, rate types.PushedRate) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&rate)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.GetPushedRateKey(rate.Denom), bz)
+}
+
+// GetPushedRate returns the rate of denom pushed by the rate authority.
+func (k Keeper) GetPushedRate(ctx context.Context, denom string) (rate types.PushedRate, found bool, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetPushedRateKey(denom))
+	if err != nil || bz == nil {
+		return rate, false, err
+	}
+
+	err = k.cdc.Unmarshal(bz, &rate)
+	return rate, err == nil, err
+}
+
+// DeletePushedRate removes the pushed rate of denom.
+func (k Keeper) DeletePushedRate(ctx context.Context, denom string) error {
+	store := k.storeService.OpenKVStore(ctx)
+	return store.Delete(types.GetPushedRateKey(denom))
+}
+
+// GetAllPushedRates returns all pushed rates.
+func (k Keeper) GetAllPushedRates(ctx context.Context) ([]types.PushedRate, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.PushedRatePrefix, storetypes.PrefixEndBytes(types.PushedRatePrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var rates []types.PushedRate
+	for ; iterator.Valid(); iterator.Next() {
+		var rate types.PushedRate
+		if err := k.cdc.Unmarshal(iterator.Value(), &rate); err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+// GetCurrentRate returns the rate currently used to convert fees paid in
+// the accepted fee denom denom: the rate pushed by the rate authority, unless
+// it is older than the max rate age, and else the static rate of the params.
+// A denom without a valid rate has the RateSourceUnspecified source.
+func (k Keeper) GetCurrentRate(ctx context.Context, params types.Params, feeDenom types.FeeDenom) (types.CurrentRate, error) {
+	pushed, found, err := k.GetPushedRate(ctx, feeDenom.Denom)
+	if err != nil {
+		return types.CurrentRate{}, err
+	}
+	height := sdk.UnwrapSDKContext(ctx).BlockHeight()
+	if found && (params.MaxRateAge == 0 || height-pushed.Height <= int64(params.MaxRateAge)) {
+		return types.CurrentRate{
+			Denom:  feeDenom.Denom,
+			Rate:   pushed.Rate,
+			Source: types.RateSourcePushed,
+			Height: pushed.Height,
+		}, nil
+	}
+
+	if feeDenom.Rate.IsPositive() {
+		return types.CurrentRate{
+			Denom:  feeDenom.Denom,
+			Rate:   feeDenom.Rate,
+			Source: types.RateSourceStatic,
+		}, nil
+	}
+
+	return types.CurrentRate{
+		Denom:  feeDenom.Denom,
+		Rate:   math.LegacyZeroDec(),
+		Source: types.RateSourceUnspecified,
+	}, nil
+}
+
+// GetCurrentRates returns the current rates of all accepted fee denoms.
+func (k Keeper) GetCurrentRates(ctx context.Context) ([]types.CurrentRate, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	rates := make([]types.CurrentRate, 0, len(params.FeeDenoms))
+	for _, feeDenom := range params.FeeDenoms {
+		rate, err := k.GetCurrentRate(ctx, params, feeDenom)
+		if err != nil {
+			return nil, err
+		}
+		rates = append(rates, rate)
+	}
+	return rates, nil
+}
+
+// getRate returns the current rate of denom, which must be accepted and have
+// a valid rate.
+func (k Keeper) getRate(ctx context.Context, params types.Params, denom string) (math.LegacyDec, error) {
+	feeDenom, ok := params.GetFeeDenom(denom)
+	if !ok {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrUnsupportedDenom, denom)
+	}
+	rate, err := k.GetCurrentRate(ctx, params, feeDenom)
+	if err != nil {
+		return math.LegacyDec{}, err
+	}
+	if rate.Source == types.RateSourceUnspecified {
+		return math.LegacyDec{}, errorsmod.Wrap(types.ErrRateUnavailable, denom)
+	}
+	return rate.Rate, nil
+}
@@ -0,0 +1,65 @@
+package keeper
+
+import (
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+var _ feemarkettypes.DenomResolver = Keeper{}
+
+// ConvertToDenom implements the feemarket DenomResolver. It converts coin to
+// denom with the current rates of the fee denoms, the rate of the feemarket
+// fee denom being one.
+func (k Keeper) ConvertToDenom(ctx sdk.Context, coin sdk.DecCoin, denom string) (sdk.DecCoin, error) {
+	if coin.Denom == denom {
+		return coin, nil
+	}
+
+	feemarketParams, err := k.feemarketKeeper.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	rate := func(denom string) (math.LegacyDec, error) {
+		if denom == feemarketParams.FeeDenom {
+			return math.LegacyOneDec(), nil
+		}
+		return k.getRate(ctx, params, denom)
+	}
+	from, err := rate(coin.Denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+	to, err := rate(denom)
+	if err != nil {
+		return sdk.DecCoin{}, err
+	}
+
+	return sdk.NewDecCoinFromDec(denom, coin.Amount.Mul(to).Quo(from)), nil
+}
+
+// ExtraDenoms implements the feemarket DenomResolver. It returns the accepted
+// fee denoms which currently have a valid rate.
+func (k Keeper) ExtraDenoms(ctx sdk.Context) ([]string, error) {
+	rates, err := k.GetCurrentRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	denoms := make([]string, 0, len(rates))
+	for _, rate := range rates {
+		if rate.Source != types.RateSourceUnspecified {
+			denoms = append(denoms, rate.Denom)
+		}
+	}
+	return denoms, nil
+}
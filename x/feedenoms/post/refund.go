@@ -0,0 +1,204 @@
+// Package post implements the x/feedenoms post handler decorators.
+package post
+
+import (
+	"context"
+	"strconv"
+
+	feemarketante "github.com/skip-mev/feemarket/x/feemarket/ante"
+	feemarketpost "github.com/skip-mev/feemarket/x/feemarket/post"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+// FeeDenomsKeeper provides the unused gas refund ratio.
+type FeeDenomsKeeper interface {
+	GetParams(ctx context.Context) (types.Params, error)
+}
+
+// DeductFeeDecorator is the feemarket FeeMarketDeductDecorator, refunding
+// part of the fee paid for the unused gas of a tx.
+//
+// The feemarket charges the fee of the consumed gas, at the current min gas
+// price, and pays the rest of the fee escrowed by the ante handler to the
+// block proposer as a tip. The unused gas refund ratio of the fee paid for
+// the unused gas, i.e. the gas limit minus the gas consumed, is taken from
+// that tip and refunded to the fee payer.
+//
+// Txs whose fee is paid by a fee grant are not refunded: the feegrant module
+// cannot give an amount back to an allowance, and a refund to the granter
+// would leave the grantee's allowance charged for it. Their refund is tipped.
+type DeductFeeDecorator struct {
+	feemarketpost.FeeMarketDeductDecorator
+
+	bankKeeper      feemarketpost.BankKeeper
+	feemarketKeeper feemarketpost.FeeMarketKeeper
+	feeDenomsKeeper FeeDenomsKeeper
+}
+
+func NewDeductFeeDecorator(
+	ak feemarketpost.AccountKeeper,
+	bk feemarketpost.BankKeeper,
+	fmk feemarketpost.FeeMarketKeeper,
+	fdk FeeDenomsKeeper,
+) DeductFeeDecorator {
+	return DeductFeeDecorator{
+		FeeMarketDeductDecorator: feemarketpost.NewFeeMarketDeductDecorator(ak, bk, fmk),
+		bankKeeper:               bk,
+		feemarketKeeper:          fmk,
+		feeDenomsKeeper:          fdk,
+	}
+}
+
+// PostHandle deducts the fee and refund as FeeMarketDeductDecorator.PostHandle
+// does, but pays the tip minus the refund.
+func (dfd DeductFeeDecorator) PostHandle(ctx sdk.Context, tx sdk.Tx, simulate, success bool, next sdk.PostHandler) (sdk.Context, error) {
+	// GenTx consume no fee
+	if ctx.BlockHeight() == 0 {
+		return next(ctx, tx, simulate, success)
+	}
+
+	feeTx, ok := tx.(sdk.FeeTx)
+	if !ok {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+	}
+
+	params, err := dfd.feeDenomsKeeper.GetParams(ctx)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to get fee denoms params")
+	}
+	ratio := params.UnusedGasRefundRatio
+	if ratio.IsNil() || !ratio.IsPositive() {
+		return dfd.FeeMarketDeductDecorator.PostHandle(ctx, tx, simulate, success, next)
+	}
+
+	if !simulate && feeTx.GetGas() == 0 {
+		return ctx, errorsmod.Wrap(sdkerrors.ErrInvalidGasLimit, "must provide positive gas")
+	}
+
+	feemarketParams, err := dfd.feemarketKeeper.GetParams(ctx)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to get fee market params")
+	}
+	if !feemarketParams.Enabled {
+		return next(ctx, tx, simulate, success)
+	}
+
+	enabledHeight, err := dfd.feemarketKeeper.GetEnabledHeight(ctx)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to get fee market enabled height")
+	}
+	if ctx.BlockHeight() <= enabledHeight {
+		return next(ctx, tx, simulate, success)
+	}
+
+	state, err := dfd.feemarketKeeper.GetState(ctx)
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to get fee market state")
+	}
+
+	feeCoins := feeTx.GetFee()
+	gas := ctx.GasMeter().GasConsumed()
+
+	if len(feeCoins) == 0 && !simulate {
+		return ctx, errorsmod.Wrapf(feemarkettypes.ErrNoFeeCoins, "got length %d", len(feeCoins))
+	}
+	if len(feeCoins) > 1 {
+		return ctx, errorsmod.Wrapf(feemarkettypes.ErrTooManyFeeCoins, "got length %d", len(feeCoins))
+	}
+
+	var (
+		tip     = sdk.NewCoin(feemarketParams.FeeDenom, math.ZeroInt())
+		payCoin = sdk.NewCoin(feemarketParams.FeeDenom, math.ZeroInt())
+		refund  = sdk.NewCoin(feemarketParams.FeeDenom, math.ZeroInt())
+	)
+	if !simulate {
+		payCoin = feeCoins[0]
+	}
+
+	feeGas := feeTx.GetGas()
+	minGasPrice, err := dfd.feemarketKeeper.GetMinGasPrice(ctx, payCoin.GetDenom())
+	if err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to get min gas price for denom %s", payCoin.GetDenom())
+	}
+
+	if !simulate {
+		fee := payCoin
+		payCoin, tip, err = feemarketante.CheckTxFee(ctx, minGasPrice, payCoin, int64(feeGas), false)
+		if err != nil {
+			return ctx, err
+		}
+		if gas < feeGas && !tip.IsNil() && !feeGranted(feeTx) {
+			refund = Refund(fee, feeGas, gas, ratio)
+			// the fee of the consumed gas is never refunded
+			if tip.IsLT(refund) {
+				refund = tip
+			}
+			tip = tip.Sub(refund)
+		}
+	}
+
+	if err := dfd.PayOutFeeAndTip(ctx, payCoin, tip); err != nil {
+		return ctx, err
+	}
+	if refund.IsPositive() {
+		if err := dfd.payOutRefund(ctx, feeTx, refund, feeGas-gas); err != nil {
+			return ctx, err
+		}
+	}
+
+	if err := state.Update(gas, feemarketParams); err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to update fee market state")
+	}
+	if err := dfd.feemarketKeeper.SetState(ctx, state); err != nil {
+		return ctx, errorsmod.Wrapf(err, "unable to set fee market state")
+	}
+
+	if simulate {
+		// consume the gas that would be consumed during normal execution, by
+		// the fee and tip sends and by the refund
+		ctx.GasMeter().ConsumeGas(2*feemarketpost.BankSendGasConsumption, "simulation send gas consumption")
+	}
+
+	return next(ctx, tx, simulate, success)
+}
+
+// feeGranted returns whether the fee of feeTx is paid by a fee grant, i.e.
+// deducted from an allowance of its fee granter to its fee payer.
+func feeGranted(feeTx sdk.FeeTx) bool {
+	granter := sdk.AccAddress(feeTx.FeeGranter())
+	return granter != nil && !granter.Equals(sdk.AccAddress(feeTx.FeePayer()))
+}
+
+// payOutRefund sends refund, held by the feemarket fee collector, to the fee
+// payer of feeTx.
+func (dfd DeductFeeDecorator) payOutRefund(ctx sdk.Context, feeTx sdk.FeeTx, refund sdk.Coin, gasUnused uint64) error {
+	refundee := sdk.AccAddress(feeTx.FeePayer())
+	if err := dfd.bankKeeper.SendCoinsFromModuleToAccount(ctx, feemarkettypes.FeeCollectorName, refundee, sdk.NewCoins(refund)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeFeeRefund,
+		sdk.NewAttribute(types.AttributeKeyRefund, refund.String()),
+		sdk.NewAttribute(types.AttributeKeyRefundee, refundee.String()),
+		sdk.NewAttribute(types.AttributeKeyGasUnused, strconv.FormatUint(gasUnused, 10)),
+	))
+	return nil
+}
+
+// Refund returns the refund of a tx paying fee for gasLimit and consuming
+// gasUsed: ratio of the fee paid for the unused gas, rounded down.
+func Refund(fee sdk.Coin, gasLimit, gasUsed uint64, ratio math.LegacyDec) sdk.Coin {
+	unusedFee := math.LegacyNewDecFromInt(fee.Amount).
+		MulInt(math.NewIntFromUint64(gasLimit - gasUsed)).
+		QuoInt(math.NewIntFromUint64(gasLimit))
+	return sdk.NewCoin(fee.Denom, unusedFee.Mul(ratio).TruncateInt())
+}
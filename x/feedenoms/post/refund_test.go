@@ -0,0 +1,155 @@
+package post_test
+
+import (
+	"testing"
+
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/feedenoms/post"
+	"github.com/cosmos/gaia/v29/x/feedenoms/types"
+)
+
+// feeTx is a tx paying fee for gas.
+type feeTx struct {
+	gas     uint64
+	fee     sdk.Coins
+	payer   sdk.AccAddress
+	granter sdk.AccAddress
+}
+
+func (feeTx) GetMsgs() []sdk.Msg                    { return nil }
+func (feeTx) GetMsgsV2() ([]protov2.Message, error) { return nil, nil }
+func (tx feeTx) GetGas() uint64                     { return tx.gas }
+func (tx feeTx) GetFee() sdk.Coins                  { return tx.fee }
+func (tx feeTx) FeePayer() []byte                   { return tx.payer }
+func (tx feeTx) FeeGranter() []byte                 { return tx.granter }
+
+func TestDeductFeeDecorator(t *testing.T) {
+	payer := sdk.AccAddress("payer")
+	granter := sdk.AccAddress("granter")
+	proposer := sdk.AccAddress("proposer")
+
+	testCases := []struct {
+		name      string
+		ratio     math.LegacyDec
+		granter   sdk.AccAddress
+		gasUsed   uint64
+		expRefund int64
+	}{
+		{
+			name:    "no refund",
+			ratio:   math.LegacyZeroDec(),
+			gasUsed: 40_000,
+		},
+		{
+			// half of 2000 * 60_000 / 100_000
+			name:      "refund",
+			ratio:     math.LegacyNewDecWithPrec(5, 1),
+			gasUsed:   40_000,
+			expRefund: 600,
+		},
+		{
+			// the allowance of the fee payer cannot be restored
+			name:    "no refund of a fee grant",
+			ratio:   math.LegacyNewDecWithPrec(5, 1),
+			granter: granter,
+			gasUsed: 40_000,
+		},
+		{
+			name:      "refund to a fee payer granting itself",
+			ratio:     math.LegacyNewDecWithPrec(5, 1),
+			granter:   payer,
+			gasUsed:   40_000,
+			expRefund: 600,
+		},
+		{
+			name:      "full refund",
+			ratio:     math.LegacyOneDec(),
+			gasUsed:   40_000,
+			expRefund: 1200,
+		},
+		{
+			name:    "no unused gas",
+			ratio:   math.LegacyOneDec(),
+			gasUsed: 100_000,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gaiaApp := helpers.Setup(t)
+			// store accesses consume no gas, so that the consumed gas is gasUsed
+			ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10, ProposerAddress: proposer}).
+				WithKVGasConfig(storetypes.GasConfig{}).
+				WithTransientKVGasConfig(storetypes.GasConfig{}).
+				WithGasMeter(storetypes.NewGasMeter(100_000)).
+				WithEventManager(sdk.NewEventManager())
+
+			feemarketParams, err := gaiaApp.FeeMarketKeeper.GetParams(ctx)
+			require.NoError(t, err)
+			feemarketParams.Enabled = true
+			feemarketParams.MinBaseGasPrice = math.LegacyNewDecWithPrec(1, 2)
+			require.NoError(t, gaiaApp.FeeMarketKeeper.SetParams(ctx, feemarketParams))
+			state, err := gaiaApp.FeeMarketKeeper.GetState(ctx)
+			require.NoError(t, err)
+			state.BaseGasPrice = feemarketParams.MinBaseGasPrice
+			require.NoError(t, gaiaApp.FeeMarketKeeper.SetState(ctx, state))
+			feeDenom := feemarketParams.FeeDenom
+
+			params := types.DefaultParams()
+			params.UnusedGasRefundRatio = tc.ratio
+			require.NoError(t, gaiaApp.FeeDenomsKeeper.SetParams(ctx, params))
+
+			// the fee escrowed by the ante handler is twice the min fee
+			fee := sdk.NewCoins(sdk.NewInt64Coin(feeDenom, 2000))
+			require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, fee))
+			require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToModule(ctx, minttypes.ModuleName, feemarkettypes.FeeCollectorName, fee))
+
+			ctx.GasMeter().ConsumeGas(tc.gasUsed, "test")
+			decorator := post.NewDeductFeeDecorator(gaiaApp.AccountKeeper, gaiaApp.BankKeeper, gaiaApp.FeeMarketKeeper, gaiaApp.FeeDenomsKeeper)
+			tx := feeTx{gas: 100_000, fee: fee, payer: payer, granter: tc.granter}
+			_, err = decorator.PostHandle(ctx, tx, false, true, func(ctx sdk.Context, _ sdk.Tx, _, _ bool) (sdk.Context, error) {
+				return ctx, nil
+			})
+			require.NoError(t, err)
+
+			// the consumed gas fee is charged, the rest is tipped
+			consumedFee := int64(tc.gasUsed) / 100
+			require.Equal(t, tc.expRefund, gaiaApp.BankKeeper.GetBalance(ctx, payer, feeDenom).Amount.Int64())
+			require.True(t, gaiaApp.BankKeeper.GetBalance(ctx, granter, feeDenom).IsZero())
+			require.Equal(t, 2000-consumedFee-tc.expRefund, gaiaApp.BankKeeper.GetBalance(ctx, proposer, feeDenom).Amount.Int64())
+
+			var refundEvents []sdk.Event
+			for _, event := range ctx.EventManager().Events() {
+				if event.Type == types.EventTypeFeeRefund {
+					refundEvents = append(refundEvents, event)
+				}
+			}
+			if tc.expRefund == 0 {
+				require.Empty(t, refundEvents)
+				return
+			}
+			require.Equal(t, []sdk.Event{sdk.NewEvent(
+				types.EventTypeFeeRefund,
+				sdk.NewAttribute(types.AttributeKeyRefund, sdk.NewInt64Coin(feeDenom, tc.expRefund).String()),
+				sdk.NewAttribute(types.AttributeKeyRefundee, payer.String()),
+				sdk.NewAttribute(types.AttributeKeyGasUnused, "60000"),
+			)}, refundEvents)
+		})
+	}
+}
+
+func TestRefund(t *testing.T) {
+	require.Equal(t, sdk.NewInt64Coin("uatom", 333), post.Refund(sdk.NewInt64Coin("uatom", 1000), 300, 100, math.LegacyNewDecWithPrec(5, 1)))
+}
@@ -0,0 +1,11 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/feedenoms module sentinel errors
+var (
+	ErrUnsupportedDenom = errors.Register(ModuleName, 2, "denom is not accepted to pay fees")
+	ErrRateUnavailable  = errors.Register(ModuleName, 3, "no valid conversion rate")
+	ErrUnauthorized     = errors.Register(ModuleName, 4, "signer is not the rate authority")
+	ErrInvalidRate      = errors.Register(ModuleName, 5, "invalid conversion rate")
+)
@@ -0,0 +1,10 @@
+package types
+
+// feedenoms module event types
+const (
+	EventTypeFeeRefund = "fee_refund"
+
+	AttributeKeyRefund    = "refund"
+	AttributeKeyRefundee  = "refundee"
+	AttributeKeyGasUnused = "gas_unused"
+)
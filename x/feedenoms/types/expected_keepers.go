@@ -0,0 +1,13 @@
+package types
+
+import (
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// FeeMarketKeeper provides the feemarket fee denom, which every fee denom
+// rate is relative to.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) (feemarkettypes.Params, error)
+}
@@ -0,0 +1,1300 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/feedenoms/v1beta1/feedenoms.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RateSource is the source of the current conversion rate of a fee denom.
+type RateSource int32
+
+const (
+	// RATE_SOURCE_UNSPECIFIED means the denom has no valid rate, and is not
+	// accepted to pay fees.
+	RateSourceUnspecified RateSource = 0
+	// RATE_SOURCE_STATIC means the static rate of the params is used.
+	RateSourceStatic RateSource = 1
+	// RATE_SOURCE_PUSHED means the rate pushed by the rate authority is used.
+	RateSourcePushed RateSource = 2
+)
+
+var RateSource_name = map[int32]string{
+	0: "RATE_SOURCE_UNSPECIFIED",
+	1: "RATE_SOURCE_STATIC",
+	2: "RATE_SOURCE_PUSHED",
+}
+
+var RateSource_value = map[string]int32{
+	"RATE_SOURCE_UNSPECIFIED": 0,
+	"RATE_SOURCE_STATIC":      1,
+	"RATE_SOURCE_PUSHED":      2,
+}
+
+func (x RateSource) String() string {
+	return proto.EnumName(RateSource_name, int32(x))
+}
+
+func (RateSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_62869304725830fc, []int{0}
+}
+
+// Params defines the parameters for the x/feedenoms module.
+type Params struct {
+	// fee_denoms are the denoms, other than the feemarket fee denom, accepted
+	// to pay fees.
+	FeeDenoms []FeeDenom `protobuf:"bytes,1,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms"`
+	// rate_authority is the address allowed to push the conversion rates of
+	// the fee denoms, e.g. TWAPs, with MsgPushRates. Empty disables pushed
+	// rates.
+	RateAuthority string `protobuf:"bytes,2,opt,name=rate_authority,json=rateAuthority,proto3" json:"rate_authority,omitempty"`
+	// max_rate_age is the number of blocks a pushed rate is used for. Zero
+	// disables the expiry of pushed rates.
+	MaxRateAge uint64 `protobuf:"varint,3,opt,name=max_rate_age,json=maxRateAge,proto3" json:"max_rate_age,omitempty"`
+	// unused_gas_refund_ratio is the fraction, between 0 and 1, of the fee paid
+	// for the unused gas of a tx, i.e. its gas limit minus its gas used, that
+	// is refunded to its fee payer, unless paid by a fee grant. The rest is paid to the
+	// block proposer as a tip. Zero disables refunds.
+	UnusedGasRefundRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=unused_gas_refund_ratio,json=unusedGasRefundRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"unused_gas_refund_ratio"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62869304725830fc, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetRateAuthority() string {
+	if m != nil {
+		return m.RateAuthority
+	}
+	return ""
+}
+
+func (m *Params) GetMaxRateAge() uint64 {
+	if m != nil {
+		return m.MaxRateAge
+	}
+	return 0
+}
+
+// FeeDenom is a denom accepted to pay fees.
+type FeeDenom struct {
+	// denom is the accepted denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the static conversion rate of the denom: the amount of denom
+	// worth one unit of the feemarket fee denom. It is used when there is no
+	// valid pushed rate. Zero requires a pushed rate.
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62869304725830fc, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// DenomRate is the conversion rate of a fee denom: the amount of denom worth
+// one unit of the feemarket fee denom.
+type DenomRate struct {
+	// denom is the fee denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the conversion rate of denom
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+}
+
+func (m *DenomRate) Reset()         { *m = DenomRate{} }
+func (m *DenomRate) String() string { return proto.CompactTextString(m) }
+func (*DenomRate) ProtoMessage()    {}
+func (*DenomRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62869304725830fc, []int{2}
+}
+func (m *DenomRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DenomRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DenomRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DenomRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DenomRate.Merge(m, src)
+}
+func (m *DenomRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *DenomRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_DenomRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DenomRate proto.InternalMessageInfo
+
+func (m *DenomRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// PushedRate is a conversion rate pushed by the rate authority.
+type PushedRate struct {
+	// denom is the fee denom
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// rate is the conversion rate of denom
+	Rate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=rate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"rate"`
+	// height is the block height the rate was pushed at
+	Height int64 `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+}
+
+func (m *PushedRate) Reset()         { *m = PushedRate{} }
+func (m *PushedRate) String() string { return proto.CompactTextString(m) }
+func (*PushedRate) ProtoMessage()    {}
+func (*PushedRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_62869304725830fc, []int{3}
+}
+func (m *PushedRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PushedRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PushedRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PushedRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PushedRate.Merge(m, src)
+}
+func (m *PushedRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PushedRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PushedRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PushedRate proto.InternalMessageInfo
+
+func (m *PushedRate) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *PushedRate) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterEnum("gaia.feedenoms.v1beta1.RateSource", RateSource_name, RateSource_value)
+	proto.RegisterType((*Params)(nil), "gaia.feedenoms.v1beta1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "gaia.feedenoms.v1beta1.FeeDenom")
+	proto.RegisterType((*DenomRate)(nil), "gaia.feedenoms.v1beta1.DenomRate")
+	proto.RegisterType((*PushedRate)(nil), "gaia.feedenoms.v1beta1.PushedRate")
+}
+
+func init() {
+	proto.RegisterFile("gaia/feedenoms/v1beta1/feedenoms.proto", fileDescriptor_62869304725830fc)
+}
+
+var fileDescriptor_62869304725830fc = []byte{
+	// 566 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x93, 0x4f, 0x6b, 0xdb, 0x3e,
+	0x1c, 0xc6, 0xad, 0x34, 0xbf, 0xf0, 0x8b, 0xf6, 0x87, 0xcc, 0x64, 0x8d, 0x9b, 0x81, 0x63, 0x72,
+	0x18, 0x21, 0xac, 0x36, 0xdd, 0xa0, 0x87, 0x5e, 0x46, 0xfe, 0x75, 0x4b, 0x19, 0x5b, 0xb0, 0x93,
+	0xcb, 0x2e, 0x46, 0xb1, 0x15, 0xdb, 0x0c, 0x5b, 0xc1, 0x92, 0x47, 0xf2, 0x06, 0xc6, 0xc8, 0x69,
+	0x6f, 0xa0, 0x30, 0x18, 0x8c, 0x1d, 0x7b, 0xe8, 0x8b, 0xc8, 0xb1, 0xf4, 0x34, 0x76, 0x28, 0x23,
+	0x39, 0x74, 0x2f, 0x63, 0xc8, 0x72, 0x49, 0xe9, 0x76, 0x1b, 0xdb, 0xc5, 0x58, 0x5f, 0x7d, 0xf4,
+	0x3c, 0x8f, 0xbe, 0x92, 0xe0, 0x43, 0x0f, 0x05, 0xc8, 0x98, 0x60, 0xec, 0xe2, 0x88, 0x84, 0xd4,
+	0x78, 0xbb, 0x37, 0xc6, 0x0c, 0xed, 0x6d, 0x2a, 0xfa, 0x34, 0x26, 0x8c, 0xc8, 0xdb, 0x9c, 0xd3,
+	0x37, 0xd5, 0x8c, 0xab, 0x96, 0x3d, 0xe2, 0x91, 0x14, 0x31, 0xf8, 0x9f, 0xa0, 0xab, 0xf7, 0x50,
+	0x18, 0x44, 0xc4, 0x48, 0xbf, 0x59, 0x69, 0xc7, 0x21, 0x34, 0x24, 0xd4, 0x16, 0xac, 0x18, 0x88,
+	0xa9, 0xfa, 0x32, 0x07, 0x0b, 0x03, 0x14, 0xa3, 0x90, 0xca, 0x47, 0x10, 0x4e, 0x30, 0xb6, 0x85,
+	0x89, 0x02, 0xb4, 0xad, 0xc6, 0xad, 0xc7, 0x9a, 0xfe, 0x7b, 0x6f, 0xfd, 0x10, 0xe3, 0x2e, 0xaf,
+	0xb4, 0x8b, 0xcb, 0x8b, 0x9a, 0xf4, 0xe5, 0xf2, 0xa4, 0x09, 0xcc, 0xe2, 0x24, 0x2b, 0x52, 0xf9,
+	0x29, 0xbc, 0x1b, 0x23, 0x86, 0x6d, 0x94, 0x30, 0x9f, 0xc4, 0x01, 0x9b, 0x2b, 0x39, 0x0d, 0x34,
+	0x8a, 0x6d, 0xe5, 0xfc, 0x74, 0xb7, 0x9c, 0x05, 0x68, 0xb9, 0x6e, 0x8c, 0x29, 0xb5, 0x58, 0x1c,
+	0x44, 0x9e, 0x79, 0x87, 0xf3, 0xad, 0x2b, 0x5c, 0xd6, 0xe0, 0xed, 0x10, 0xcd, 0x6c, 0x21, 0xe2,
+	0x61, 0x65, 0x4b, 0x03, 0x8d, 0xbc, 0x09, 0x43, 0x34, 0x33, 0x39, 0xe7, 0x61, 0x39, 0x84, 0x95,
+	0x24, 0x4a, 0x28, 0x76, 0x6d, 0x0f, 0x51, 0x3b, 0xc6, 0x93, 0x24, 0x72, 0x39, 0x1f, 0x10, 0x25,
+	0x9f, 0x7a, 0xed, 0xf3, 0x64, 0xdf, 0x2e, 0x6a, 0x0f, 0x84, 0x1f, 0x75, 0xdf, 0xe8, 0x01, 0x31,
+	0x42, 0xc4, 0x7c, 0xfd, 0x05, 0xf6, 0x90, 0x33, 0xef, 0x62, 0xe7, 0xfc, 0x74, 0x17, 0x66, 0x71,
+	0xba, 0xd8, 0x11, 0xdb, 0x28, 0x0b, 0xd9, 0x67, 0x88, 0x9a, 0xa9, 0xa8, 0xc9, 0x35, 0x0f, 0xb4,
+	0x1f, 0x1f, 0x6b, 0x60, 0x71, 0x79, 0xd2, 0xac, 0xa4, 0xa7, 0x36, 0xbb, 0x76, 0x6e, 0xa2, 0x7f,
+	0xf5, 0x18, 0xfe, 0x7f, 0xd5, 0x15, 0xb9, 0x0c, 0xff, 0x4b, 0x27, 0x15, 0xc0, 0xa3, 0x98, 0x62,
+	0x20, 0x1f, 0xc1, 0x3c, 0xdf, 0x90, 0x92, 0xfb, 0xa3, 0x7c, 0xa9, 0xc6, 0x41, 0x9e, 0xe7, 0xa9,
+	0x87, 0xb0, 0x98, 0x1a, 0xf2, 0xa6, 0xfc, 0x7d, 0xd3, 0xfa, 0x3b, 0x00, 0xe1, 0x20, 0xa1, 0x3e,
+	0x76, 0xff, 0x8d, 0xa1, 0xbc, 0x0d, 0x0b, 0x3e, 0x0e, 0x3c, 0x9f, 0xa5, 0x17, 0x60, 0xcb, 0xcc,
+	0x46, 0xcd, 0xcf, 0x00, 0x42, 0x1e, 0xc1, 0x22, 0x49, 0xec, 0x60, 0x79, 0x1f, 0x56, 0xcc, 0xd6,
+	0xb0, 0x67, 0x5b, 0xaf, 0x46, 0x66, 0xa7, 0x67, 0x8f, 0x5e, 0x5a, 0x83, 0x5e, 0xa7, 0x7f, 0xd8,
+	0xef, 0x75, 0x4b, 0x52, 0x75, 0x67, 0x71, 0xac, 0xdd, 0xdf, 0xc0, 0xa3, 0x88, 0x4e, 0xb1, 0x13,
+	0x4c, 0x02, 0xec, 0xca, 0x8f, 0xa0, 0x7c, 0x7d, 0x9d, 0x35, 0x6c, 0x0d, 0xfb, 0x9d, 0x12, 0xa8,
+	0x96, 0x17, 0xc7, 0x5a, 0x69, 0xb3, 0xc4, 0x62, 0x88, 0x05, 0xce, 0x4d, 0x7a, 0x30, 0xb2, 0x9e,
+	0xf7, 0xba, 0xa5, 0xdc, 0x4d, 0x5a, 0x34, 0xa8, 0x9a, 0x7f, 0xff, 0x49, 0x95, 0xda, 0xed, 0xe5,
+	0x4a, 0x05, 0x67, 0x2b, 0x15, 0x7c, 0x5f, 0xa9, 0xe0, 0xc3, 0x5a, 0x95, 0xce, 0xd6, 0xaa, 0xf4,
+	0x75, 0xad, 0x4a, 0xaf, 0x1b, 0x5e, 0xc0, 0xfc, 0x64, 0xac, 0x3b, 0x24, 0xcc, 0x9e, 0xa4, 0xf1,
+	0xcb, 0xcd, 0x62, 0xf3, 0x29, 0xa6, 0xe3, 0x42, 0xfa, 0x54, 0x9f, 0xfc, 0x1c, 0x00, 0x92, 0x78,
+	0x5d, 0x9c, 0x30, 0x04, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.FeeDenoms) != len(that1.FeeDenoms) {
+		return false
+	}
+	for i := range this.FeeDenoms {
+		if !this.FeeDenoms[i].Equal(&that1.FeeDenoms[i]) {
+			return false
+		}
+	}
+	if this.RateAuthority != that1.RateAuthority {
+		return false
+	}
+	if this.MaxRateAge != that1.MaxRateAge {
+		return false
+	}
+	if !this.UnusedGasRefundRatio.Equal(that1.UnusedGasRefundRatio) {
+		return false
+	}
+	return true
+}
+func (this *FeeDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDenom)
+	if !ok {
+		that2, ok := that.(FeeDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if !this.Rate.Equal(that1.Rate) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.UnusedGasRefundRatio.Size()
+		i -= size
+		if _, err := m.UnusedGasRefundRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeedenoms(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.MaxRateAge != 0 {
+		i = encodeVarintFeedenoms(dAtA, i, uint64(m.MaxRateAge))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.RateAuthority) > 0 {
+		i -= len(m.RateAuthority)
+		copy(dAtA[i:], m.RateAuthority)
+		i = encodeVarintFeedenoms(dAtA, i, uint64(len(m.RateAuthority)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintFeedenoms(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeedenoms(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeedenoms(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *DenomRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DenomRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DenomRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeedenoms(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeedenoms(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PushedRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PushedRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PushedRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Height != 0 {
+		i = encodeVarintFeedenoms(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Rate.Size()
+		i -= size
+		if _, err := m.Rate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintFeedenoms(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintFeedenoms(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintFeedenoms(dAtA []byte, offset int, v uint64) int {
+	offset -= sovFeedenoms(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovFeedenoms(uint64(l))
+		}
+	}
+	l = len(m.RateAuthority)
+	if l > 0 {
+		n += 1 + l + sovFeedenoms(uint64(l))
+	}
+	if m.MaxRateAge != 0 {
+		n += 1 + sovFeedenoms(uint64(m.MaxRateAge))
+	}
+	l = m.UnusedGasRefundRatio.Size()
+	n += 1 + l + sovFeedenoms(uint64(l))
+	return n
+}
+
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeedenoms(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeedenoms(uint64(l))
+	return n
+}
+
+func (m *DenomRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeedenoms(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeedenoms(uint64(l))
+	return n
+}
+
+func (m *PushedRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovFeedenoms(uint64(l))
+	}
+	l = m.Rate.Size()
+	n += 1 + l + sovFeedenoms(uint64(l))
+	if m.Height != 0 {
+		n += 1 + sovFeedenoms(uint64(m.Height))
+	}
+	return n
+}
+
+func sovFeedenoms(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozFeedenoms(x uint64) (n int) {
+	return sovFeedenoms(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedenoms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateAuthority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateAuthority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxRateAge", wireType)
+			}
+			m.MaxRateAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxRateAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnusedGasRefundRatio", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.UnusedGasRefundRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedenoms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedenoms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedenoms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *DenomRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedenoms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DenomRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DenomRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedenoms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PushedRate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowFeedenoms
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PushedRate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PushedRate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Rate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Rate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipFeedenoms(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthFeedenoms
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipFeedenoms(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowFeedenoms
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowFeedenoms
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthFeedenoms
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupFeedenoms
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthFeedenoms
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthFeedenoms        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowFeedenoms          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupFeedenoms = fmt.Errorf("proto: unexpected end of group")
+)
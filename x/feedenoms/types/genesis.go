@@ -0,0 +1,37 @@
+package types
+
+import "fmt"
+
+func NewGenesisState(params Params, pushedRates []PushedRate) *GenesisState {
+	return &GenesisState{
+		Params:      params,
+		PushedRates: pushedRates,
+	}
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func ValidateGenesis(gs *GenesisState) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	denoms := make(map[string]struct{}, len(gs.PushedRates))
+	for _, rate := range gs.PushedRates {
+		if _, ok := gs.Params.GetFeeDenom(rate.Denom); !ok {
+			return fmt.Errorf("pushed rate of %s: %w", rate.Denom, ErrUnsupportedDenom)
+		}
+		if _, ok := denoms[rate.Denom]; ok {
+			return fmt.Errorf("duplicate pushed rate: %s", rate.Denom)
+		}
+		denoms[rate.Denom] = struct{}{}
+		if rate.Rate.IsNil() || !rate.Rate.IsPositive() {
+			return fmt.Errorf("pushed rate of %s must be positive", rate.Denom)
+		}
+	}
+	return nil
+}
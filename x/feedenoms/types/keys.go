@@ -0,0 +1,23 @@
+package types
+
+const (
+	// ModuleName is the name of the feedenoms module
+	ModuleName = "feedenoms"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the feedenoms module
+	RouterKey = ModuleName
+)
+
+var (
+	ParamsKey        = []byte{0x01} // key for the parameters of module x/feedenoms
+	PushedRatePrefix = []byte{0x02} // prefix for the rates pushed by the rate authority
+)
+
+// GetPushedRateKey returns the key of the rate of denom pushed by the rate
+// authority
+func GetPushedRateKey(denom string) []byte {
+	return append(PushedRatePrefix, []byte(denom)...)
+}
@@ -0,0 +1,66 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance
+func NewParams(rateAuthority string, maxRateAge uint64, feeDenoms ...FeeDenom) Params {
+	return Params{
+		FeeDenoms:            feeDenoms,
+		RateAuthority:        rateAuthority,
+		MaxRateAge:           maxRateAge,
+		UnusedGasRefundRatio: math.LegacyZeroDec(),
+	}
+}
+
+// DefaultParams returns a default set of parameters: only the feemarket fee
+// denom is accepted, and unused gas is not refunded.
+func DefaultParams() Params {
+	return NewParams("", 0)
+}
+
+// validate a set of params
+func (p Params) Validate() error {
+	if p.RateAuthority != "" {
+		if _, err := sdk.AccAddressFromBech32(p.RateAuthority); err != nil {
+			return fmt.Errorf("invalid rate authority %s: %w", p.RateAuthority, err)
+		}
+	}
+
+	denoms := make(map[string]struct{}, len(p.FeeDenoms))
+	for _, feeDenom := range p.FeeDenoms {
+		if err := sdk.ValidateDenom(feeDenom.Denom); err != nil {
+			return fmt.Errorf("invalid fee denom: %w", err)
+		}
+		if _, ok := denoms[feeDenom.Denom]; ok {
+			return fmt.Errorf("duplicate fee denom: %s", feeDenom.Denom)
+		}
+		denoms[feeDenom.Denom] = struct{}{}
+		if feeDenom.Rate.IsNil() || feeDenom.Rate.IsNegative() {
+			return fmt.Errorf("fee denom %s: rate must be non-negative", feeDenom.Denom)
+		}
+		if feeDenom.Rate.IsZero() && p.RateAuthority == "" {
+			return fmt.Errorf("fee denom %s: a zero rate requires a rate authority", feeDenom.Denom)
+		}
+	}
+
+	if p.UnusedGasRefundRatio.IsNil() || p.UnusedGasRefundRatio.IsNegative() || p.UnusedGasRefundRatio.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("unused gas refund ratio must be between 0 and 1: %s", p.UnusedGasRefundRatio)
+	}
+	return nil
+}
+
+// GetFeeDenom returns the accepted fee denom denom.
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+	return FeeDenom{}, false
+}
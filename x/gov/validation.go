@@ -31,10 +31,25 @@ func ValidateVoterStake(ctx sdk.Context, stakingKeeper *stakingkeeper.Keeper, vo
 		return nil
 	}
 
+	enoughStake, err := HasMinStake(ctx, stakingKeeper, voter, minStakedTokens)
+	if err != nil {
+		return err
+	}
+
+	if !enoughStake {
+		return errorsmod.Wrapf(gaiaerrors.ErrInsufficientStake, "insufficient stake for voting - min required %v", minStakedTokens)
+	}
+
+	return nil
+}
+
+// HasMinStake reports whether the tokens delegated by addr amount to at least
+// minStake. Only the first maxDelegationsChecked delegations are considered.
+func HasMinStake(ctx sdk.Context, stakingKeeper *stakingkeeper.Keeper, addr sdk.AccAddress, minStake math.LegacyDec) (bool, error) {
 	enoughStake := false
 	delegationCount := 0
 	stakedTokens := math.LegacyNewDec(0)
-	err := stakingKeeper.IterateDelegatorDelegations(ctx, voter, func(delegation stakingtypes.Delegation) bool {
+	err := stakingKeeper.IterateDelegatorDelegations(ctx, addr, func(delegation stakingtypes.Delegation) bool {
 		validatorAddr, err := sdk.ValAddressFromBech32(delegation.ValidatorAddress)
 		if err != nil {
 			panic(err) // shouldn't happen
@@ -44,7 +59,7 @@ func ValidateVoterStake(ctx sdk.Context, stakingKeeper *stakingkeeper.Keeper, vo
 			shares := delegation.Shares
 			tokens := validator.TokensFromSharesTruncated(shares)
 			stakedTokens = stakedTokens.Add(tokens)
-			if stakedTokens.GTE(minStakedTokens) {
+			if stakedTokens.GTE(minStake) {
 				enoughStake = true
 				return true // break the iteration
 			}
@@ -53,13 +68,5 @@ func ValidateVoterStake(ctx sdk.Context, stakingKeeper *stakingkeeper.Keeper, vo
 		// break the iteration if maxDelegationsChecked were already checked
 		return delegationCount >= maxDelegationsChecked
 	})
-	if err != nil {
-		return err
-	}
-
-	if !enoughStake {
-		return errorsmod.Wrapf(gaiaerrors.ErrInsufficientStake, "insufficient stake for voting - min required %v", minStakedTokens)
-	}
-
-	return nil
+	return enoughStake, err
 }
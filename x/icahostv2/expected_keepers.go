@@ -0,0 +1,24 @@
+package icahostv2
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+)
+
+// HostKeeper provides the params and interchain account addresses of the
+// ICS-27 host submodule.
+type HostKeeper interface {
+	GetParams(ctx sdk.Context) icahosttypes.Params
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+	SetInterchainAccountAddress(ctx sdk.Context, connectionID, portID, address string)
+}
+
+// AccountKeeper creates the interchain accounts.
+type AccountKeeper interface {
+	NewAccount(ctx context.Context, acc sdk.AccountI) sdk.AccountI
+	GetAccount(ctx context.Context, addr sdk.AccAddress) sdk.AccountI
+	SetAccount(ctx context.Context, acc sdk.AccountI)
+}
@@ -0,0 +1,263 @@
+// Package icahostv2 implements the interchain accounts host over IBC v2.
+//
+// IBC v2 has no channel handshake registering the interchain account of a
+// controller, so the account controlled by a port of a counterparty is
+// created on the first packet it sends to the host port, at an address that
+// only depends on the host client ID and the controller port ID. The host
+// params, i.e. the host enabled flag and the allowed messages, and the
+// interchain account addresses are those of the ICS-27 host submodule.
+package icahostv2
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/cosmos/gogoproto/proto"
+
+	errorsmod "cosmossdk.io/errors"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkaddress "github.com/cosmos/cosmos-sdk/types/address"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	ibcerrors "github.com/cosmos/ibc-go/v10/modules/core/errors"
+)
+
+// AttributeKeyHostClientID is the attribute of the ICS-27 packet events
+// holding the host client ID of an IBC v2 packet.
+const AttributeKeyHostClientID = "host_client_id"
+
+var (
+	_ api.IBCModule             = (*IBCModule)(nil)
+	_ api.PacketDataUnmarshaler = (*IBCModule)(nil)
+)
+
+// IBCModule is the IBC v2 application of the interchain accounts host.
+type IBCModule struct {
+	cdc           codec.Codec
+	hostKeeper    HostKeeper
+	accountKeeper AccountKeeper
+	msgRouter     icatypes.MessageRouter
+}
+
+// NewIBCModule creates a new IBCModule.
+func NewIBCModule(cdc codec.Codec, hostKeeper HostKeeper, accountKeeper AccountKeeper, msgRouter icatypes.MessageRouter) *IBCModule {
+	return &IBCModule{
+		cdc:           cdc,
+		hostKeeper:    hostKeeper,
+		accountKeeper: accountKeeper,
+		msgRouter:     msgRouter,
+	}
+}
+
+// AccountAddress returns the address of the interchain account controlled
+// by the controller port ID of the counterparty of the host client ID.
+func AccountAddress(clientID, controllerPortID string) sdk.AccAddress {
+	hostModuleAcc := sdkaddress.Module(icatypes.ModuleName, []byte(icatypes.HostPortID))
+	return sdkaddress.Derive(hostModuleAcc, []byte(clientID+"/"+controllerPortID))
+}
+
+// OnSendPacket implements the IBCModule interface. A host does not send
+// packets.
+func (*IBCModule) OnSendPacket(sdk.Context, string, string, uint64, channeltypesv2.Payload, sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot send a packet from an interchain accounts host")
+}
+
+// OnRecvPacket implements the IBCModule interface. It executes the
+// transaction of the packet as the interchain account of the source port,
+// creating the account if needed, and acknowledges the packet with the
+// marshaled TxMsgData of the transaction, as over IBC v1.
+func (im *IBCModule) OnRecvPacket(
+	ctx sdk.Context,
+	_ string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	_ sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	logger := ctx.Logger().With("module", "x/"+icatypes.ModuleName+"-v2")
+
+	txResponse, err := im.onRecvPacket(ctx, destinationClient, payload)
+	im.emitPacketEvent(ctx, destinationClient, err)
+	if err != nil {
+		logger.Error(fmt.Sprintf("%s sequence %d", err.Error(), sequence))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+	logger.Info("successfully handled packet", "sequence", sequence)
+
+	return channeltypesv2.RecvPacketResult{
+		Status:          channeltypesv2.PacketStatus_Success,
+		Acknowledgement: channeltypes.NewResultAcknowledgement(txResponse).Acknowledgement(),
+	}
+}
+
+func (im *IBCModule) onRecvPacket(ctx sdk.Context, destinationClient string, payload channeltypesv2.Payload) ([]byte, error) {
+	if !im.hostKeeper.GetParams(ctx).HostEnabled {
+		return nil, icahosttypes.ErrHostSubModuleDisabled
+	}
+	if payload.Version != icatypes.Version {
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidVersion, "expected %s, got %s", icatypes.Version, payload.Version)
+	}
+	if payload.Encoding != icatypes.EncodingProtobuf && payload.Encoding != icatypes.EncodingProto3JSON {
+		return nil, errorsmod.Wrapf(icatypes.ErrInvalidCodec, "unsupported encoding format %s", payload.Encoding)
+	}
+
+	data, err := unmarshalPacketData(payload)
+	if err != nil {
+		return nil, err
+	}
+	if data.Type != icatypes.EXECUTE_TX {
+		return nil, icatypes.ErrUnknownDataType
+	}
+	msgs, err := icatypes.DeserializeCosmosTx(im.cdc, data.Data, payload.Encoding)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to deserialize interchain account transaction")
+	}
+
+	// CacheContext returns a new context with the multi-store branched into a cached storage object
+	// writeCache is called only if the account is registered and all msgs succeed
+	cacheCtx, writeCache := ctx.CacheContext()
+	account, err := im.getOrCreateAccount(cacheCtx, destinationClient, payload.SourcePort)
+	if err != nil {
+		return nil, err
+	}
+	txResponse, err := im.executeTx(cacheCtx, account, msgs)
+	if err != nil {
+		return nil, errorsmod.Wrapf(err, "failed to execute interchain account transaction")
+	}
+	writeCache()
+
+	return txResponse, nil
+}
+
+// getOrCreateAccount returns the interchain account controlled by the
+// controller port ID, and creates it if needed. An account without public key
+// nor sequence at the address of the interchain account, e.g. created by a
+// send to this address, is converted to the interchain account.
+func (im *IBCModule) getOrCreateAccount(ctx sdk.Context, clientID, controllerPortID string) (sdk.AccAddress, error) {
+	if address, found := im.hostKeeper.GetInterchainAccountAddress(ctx, clientID, controllerPortID); found {
+		return sdk.AccAddressFromBech32(address)
+	}
+
+	address := AccountAddress(clientID, controllerPortID)
+	interchainAccount := icatypes.NewInterchainAccount(authtypes.NewBaseAccountWithAddress(address), controllerPortID)
+	switch acc := im.accountKeeper.GetAccount(ctx, address).(type) {
+	case nil:
+		im.accountKeeper.NewAccount(ctx, interchainAccount)
+	case *authtypes.BaseAccount:
+		if acc.GetPubKey() != nil || acc.GetSequence() != 0 {
+			return nil, errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for interchain account address %s", address)
+		}
+		interchainAccount.BaseAccount = acc
+	default:
+		return nil, errorsmod.Wrapf(icatypes.ErrAccountAlreadyExist, "existing account for interchain account address %s", address)
+	}
+
+	im.accountKeeper.SetAccount(ctx, interchainAccount)
+	im.hostKeeper.SetInterchainAccountAddress(ctx, clientID, controllerPortID, address.String())
+
+	return address, nil
+}
+
+// executeTx authenticates and executes the messages of the transaction as the
+// interchain account, as the ICS-27 host does.
+func (im *IBCModule) executeTx(ctx sdk.Context, account sdk.AccAddress, msgs []sdk.Msg) ([]byte, error) {
+	allowMsgs := im.hostKeeper.GetParams(ctx).AllowMessages
+	for _, msg := range msgs {
+		if !icahosttypes.ContainsMsgType(allowMsgs, msg) {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "message type not allowed: %s", sdk.MsgTypeURL(msg))
+		}
+
+		signers, _, err := im.cdc.GetMsgV1Signers(msg)
+		if err != nil {
+			return nil, errorsmod.Wrapf(err, "failed to obtain message signers for message type %s", sdk.MsgTypeURL(msg))
+		}
+		for _, signer := range signers {
+			if !account.Equals(sdk.AccAddress(signer)) {
+				return nil, errorsmod.Wrapf(ibcerrors.ErrUnauthorized, "unexpected signer address: expected %s, got %s", account, sdk.AccAddress(signer))
+			}
+		}
+	}
+
+	txMsgData := &sdk.TxMsgData{
+		MsgResponses: make([]*codectypes.Any, len(msgs)),
+	}
+	for i, msg := range msgs {
+		if m, ok := msg.(sdk.HasValidateBasic); ok {
+			if err := m.ValidateBasic(); err != nil {
+				return nil, err
+			}
+		}
+
+		handler := im.msgRouter.Handler(msg)
+		if handler == nil {
+			return nil, icatypes.ErrInvalidRoute
+		}
+		res, err := handler(ctx, msg)
+		if err != nil {
+			return nil, err
+		}
+
+		// NOTE: The sdk msg handler creates a new EventManager, so events must be correctly propagated back to the current context
+		ctx.EventManager().EmitEvents(res.GetEvents())
+		if len(res.MsgResponses) == 0 || res.MsgResponses[0] == nil {
+			return nil, errorsmod.Wrapf(ibcerrors.ErrLogic, "got nil Msg response for msg %s", sdk.MsgTypeURL(msg))
+		}
+		txMsgData.MsgResponses[i] = res.MsgResponses[0]
+	}
+
+	txResponse, err := proto.Marshal(txMsgData)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "failed to marshal tx data")
+	}
+	return txResponse, nil
+}
+
+// emitPacketEvent emits the ICS-27 packet event of a received packet.
+func (*IBCModule) emitPacketEvent(ctx sdk.Context, destinationClient string, err error) {
+	attributes := []sdk.Attribute{
+		sdk.NewAttribute(sdk.AttributeKeyModule, icatypes.ModuleName),
+		sdk.NewAttribute(AttributeKeyHostClientID, destinationClient),
+		sdk.NewAttribute(icatypes.AttributeKeyAckSuccess, strconv.FormatBool(err == nil)),
+	}
+	if err != nil {
+		attributes = append(attributes, sdk.NewAttribute(icatypes.AttributeKeyAckError, err.Error()))
+	}
+	ctx.EventManager().EmitEvent(sdk.NewEvent(icatypes.EventTypePacket, attributes...))
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface. A host does not
+// send packets.
+func (*IBCModule) OnAcknowledgementPacket(sdk.Context, string, string, uint64, []byte, channeltypesv2.Payload, sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot receive acknowledgement on an interchain accounts host, a host does not send packets")
+}
+
+// OnTimeoutPacket implements the IBCModule interface. A host does not send
+// packets.
+func (*IBCModule) OnTimeoutPacket(sdk.Context, string, string, uint64, channeltypesv2.Payload, sdk.AccAddress) error {
+	return errorsmod.Wrap(icatypes.ErrInvalidChannelFlow, "cannot cause a packet timeout on an interchain accounts host, a host does not send packets")
+}
+
+// UnmarshalPacketData unmarshals the JSON encoded InterchainAccountPacketData
+// of the payload. It implements the PacketDataUnmarshaler interface required
+// by the callbacks middleware.
+func (*IBCModule) UnmarshalPacketData(payload channeltypesv2.Payload) (any, error) {
+	return unmarshalPacketData(payload)
+}
+
+func unmarshalPacketData(payload channeltypesv2.Payload) (icatypes.InterchainAccountPacketData, error) {
+	var data icatypes.InterchainAccountPacketData
+	if err := data.UnmarshalJSON(payload.Value); err != nil {
+		return data, errorsmod.Wrapf(ibcerrors.ErrInvalidType, "cannot unmarshal ICS-27 interchain account packet data")
+	}
+	return data, nil
+}
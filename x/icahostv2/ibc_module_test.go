@@ -0,0 +1,115 @@
+package icahostv2_test
+
+import (
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/icahostv2"
+)
+
+const (
+	hostClientID     = "07-tendermint-0"
+	controllerPortID = "wasm2cosmos1contract"
+)
+
+func TestOnRecvPacket(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	cdc := gaiaApp.AppCodec()
+	module := icahostv2.NewIBCModule(cdc, gaiaApp.ICAHostKeeper, gaiaApp.AccountKeeper, gaiaApp.MsgServiceRouter())
+
+	require.True(t, gaiaApp.IBCKeeper.ChannelKeeperV2.Router.HasRoute(icahosttypes.SubModuleName))
+
+	icaAddr := icahostv2.AccountAddress(hostClientID, controllerPortID)
+	bob := sdk.AccAddress("bob_________________")
+	// a send to the interchain account address creates a base account
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000))
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, icaAddr, funds))
+	accountNumber := gaiaApp.AccountKeeper.GetAccount(ctx, icaAddr).GetAccountNumber()
+
+	payload := func(encoding string, msgs ...proto.Message) channeltypesv2.Payload {
+		bz, err := icatypes.SerializeCosmosTx(cdc, msgs, encoding)
+		require.NoError(t, err)
+		data := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: bz}
+		return channeltypesv2.Payload{
+			SourcePort:      controllerPortID,
+			DestinationPort: icahosttypes.SubModuleName,
+			Version:         icatypes.Version,
+			Encoding:        encoding,
+			Value:           data.GetBytes(),
+		}
+	}
+	send := func(from sdk.AccAddress, amount int64) proto.Message {
+		return banktypes.NewMsgSend(from, bob, sdk.NewCoins(sdk.NewInt64Coin("uatom", amount)))
+	}
+	recv := func(payload channeltypesv2.Payload) channeltypesv2.RecvPacketResult {
+		return module.OnRecvPacket(ctx, "07-tendermint-5", hostClientID, 1, payload, nil)
+	}
+	failure := channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+
+	// the signers must be the interchain account
+	require.Equal(t, failure, recv(payload(icatypes.EncodingProtobuf, send(icaAddr, 100), send(bob, 0))))
+	_, found := gaiaApp.ICAHostKeeper.GetInterchainAccountAddress(ctx, hostClientID, controllerPortID)
+	require.False(t, found)
+
+	// the first packet registers the interchain account
+	res := recv(payload(icatypes.EncodingProtobuf, send(icaAddr, 100)))
+	require.Equal(t, channeltypesv2.PacketStatus_Success, res.Status)
+	var ack channeltypes.Acknowledgement
+	require.NoError(t, icatypes.ModuleCdc.UnmarshalJSON(res.Acknowledgement, &ack))
+	var txMsgData sdk.TxMsgData
+	require.NoError(t, proto.Unmarshal(ack.GetResult(), &txMsgData))
+	require.Len(t, txMsgData.MsgResponses, 1)
+	require.Equal(t, sdk.MsgTypeURL(&banktypes.MsgSendResponse{}), txMsgData.MsgResponses[0].TypeUrl)
+
+	address, found := gaiaApp.ICAHostKeeper.GetInterchainAccountAddress(ctx, hostClientID, controllerPortID)
+	require.True(t, found)
+	require.Equal(t, icaAddr.String(), address)
+	account, ok := gaiaApp.AccountKeeper.GetAccount(ctx, icaAddr).(*icatypes.InterchainAccount)
+	require.True(t, ok)
+	require.Equal(t, controllerPortID, account.AccountOwner)
+	require.Equal(t, accountNumber, account.AccountNumber)
+	require.Equal(t, int64(100), gaiaApp.BankKeeper.GetBalance(ctx, bob, "uatom").Amount.Int64())
+
+	// the interchain account of another controller port or client differs
+	require.NotEqual(t, icaAddr, icahostv2.AccountAddress("07-tendermint-1", controllerPortID))
+	require.NotEqual(t, icaAddr, icahostv2.AccountAddress(hostClientID, "wasm2cosmos1other"))
+
+	res = recv(payload(icatypes.EncodingProto3JSON, send(icaAddr, 50)))
+	require.Equal(t, channeltypesv2.PacketStatus_Success, res.Status)
+	require.Equal(t, int64(150), gaiaApp.BankKeeper.GetBalance(ctx, bob, "uatom").Amount.Int64())
+
+	// failed transactions are reverted
+	require.Equal(t, failure, recv(payload(icatypes.EncodingProtobuf, send(icaAddr, 100), send(icaAddr, 10_000))))
+	require.Equal(t, int64(150), gaiaApp.BankKeeper.GetBalance(ctx, bob, "uatom").Amount.Int64())
+
+	invalid := payload(icatypes.EncodingProtobuf, send(icaAddr, 1))
+	invalid.Version = "ics20-1"
+	require.Equal(t, failure, recv(invalid))
+	invalid = payload(icatypes.EncodingProtobuf, send(icaAddr, 1))
+	invalid.Encoding = "application/x-protobuf"
+	require.Equal(t, failure, recv(invalid))
+
+	gaiaApp.ICAHostKeeper.SetParams(ctx, icahosttypes.NewParams(true, []string{sdk.MsgTypeURL(&banktypes.MsgMultiSend{})}))
+	require.Equal(t, failure, recv(payload(icatypes.EncodingProtobuf, send(icaAddr, 1))))
+	gaiaApp.ICAHostKeeper.SetParams(ctx, icahosttypes.NewParams(false, []string{icahosttypes.AllowAllHostMsgs}))
+	require.Equal(t, failure, recv(payload(icatypes.EncodingProtobuf, send(icaAddr, 1))))
+	require.Equal(t, int64(150), gaiaApp.BankKeeper.GetBalance(ctx, bob, "uatom").Amount.Int64())
+
+	require.ErrorIs(t, module.OnSendPacket(ctx, hostClientID, "07-tendermint-5", 1, invalid, icaAddr), icatypes.ErrInvalidChannelFlow)
+}
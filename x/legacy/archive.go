@@ -0,0 +1,308 @@
+// Package legacy keeps the messages and governance proposals of removed
+// modules decodable, so that historical transactions and proposals stored in
+// state can still be queried after a module has been removed.
+//
+// The types of a removed module are described by an Archive of its proto
+// files. The input types of its Msg services are registered as sdk.Msg, and
+// the messages implementing cosmos.gov.v1beta1.Content as legacy governance
+// proposal contents. They are decoded with the archived field layouts, keep
+// their original bytes, and reject new transactions in ValidateBasic.
+package legacy
+
+import (
+	"bytes"
+	"compress/gzip"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+
+	"github.com/cosmos/gaia/v29/x/legacy/types"
+)
+
+// Archive is the set of archived types of a removed module.
+type Archive struct {
+	module string
+	types  []*archivedType
+	// byGoType maps the Go types of the archived messages to them
+	byGoType map[reflect.Type]*archivedType
+}
+
+// archivedType is an archived message, decoded by the Go type goType.
+type archivedType struct {
+	module string
+	// iface is the name of the implemented interface, types.MsgInterface or
+	// types.ContentInterface.
+	iface  string
+	desc   protoreflect.MessageDescriptor
+	goType reflect.Type
+
+	// wireDesc is the lenient layout of desc, see wireDescriptor, and
+	// wireFile the gzipped FileDescriptorProto of its file.
+	wireDesc protoreflect.MessageDescriptor
+	wireFile []byte
+	index    []int
+}
+
+// NewArchive loads the archived proto files of a removed module, and
+// registers them in the protov2 registries so that the archived types are
+// also resolved by the amino JSON encoder. files must be ordered by
+// dependency. Files still registered by the binary, e.g. dependencies shared
+// with existing modules, are not archived.
+//
+// bindings binds every archived message, by full name, to a distinct Go type
+// instantiated from Message, whose Type returns the returned archive.
+//
+// NewArchive is meant to be called once per removed module, when
+// initializing the package archiving it.
+func NewArchive(
+	module string,
+	files []*descriptorpb.FileDescriptorProto,
+	bindings map[protoreflect.FullName]gogoproto.Message,
+) (*Archive, error) {
+	archive := &Archive{module: module, byGoType: make(map[reflect.Type]*archivedType)}
+	r := resolver{local: new(protoregistry.Files)}
+	for _, fdp := range files {
+		if _, err := gogoproto.HybridResolver.FindFileByPath(fdp.GetName()); err == nil {
+			continue
+		}
+		fd, err := protodesc.NewFile(fdp, r)
+		if err != nil {
+			return nil, fmt.Errorf("invalid archived file %s: %w", fdp.GetName(), err)
+		}
+		if err := r.local.RegisterFile(fd); err != nil {
+			return nil, err
+		}
+
+		wire, wireFile, err := wireDescriptor(fdp)
+		if err != nil {
+			return nil, err
+		}
+		for _, t := range archivedMessages(fd) {
+			t.module = module
+			t.wireDesc = wire.Messages().Get(t.desc.Index())
+			t.wireFile = wireFile
+			t.index = []int{t.desc.Index()}
+			archive.types = append(archive.types, t)
+		}
+	}
+
+	// bind the archived types before registering anything, so that invalid
+	// bindings leave the registries untouched
+	msgs := make([]gogoproto.Message, len(archive.types))
+	for i, t := range archive.types {
+		msg, ok := bindings[t.desc.FullName()]
+		if !ok {
+			return nil, fmt.Errorf("cannot archive %s: no Go type bound to it", t.desc.FullName())
+		}
+		if _, ok := msg.(archivedMessage); !ok {
+			return nil, fmt.Errorf("cannot bind %s to %T: not a legacy.Message", t.desc.FullName(), msg)
+		}
+		if bound, ok := archive.byGoType[reflect.TypeOf(msg)]; ok {
+			return nil, fmt.Errorf("cannot bind %s to %T: already bound to %s", t.desc.FullName(), msg, bound.desc.FullName())
+		}
+		t.goType = reflect.TypeOf(msg)
+		archive.byGoType[t.goType] = t
+		msgs[i] = msg
+	}
+
+	var err error
+	r.local.RangeFiles(func(fd protoreflect.FileDescriptor) bool {
+		if err = protoregistry.GlobalFiles.RegisterFile(fd); err != nil {
+			return false
+		}
+		err = registerMessageTypes(fd.Messages())
+		return err == nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	for i, t := range archive.types {
+		if gogoproto.MessageType(string(t.desc.FullName())) == nil {
+			gogoproto.RegisterType(msgs[i], string(t.desc.FullName()))
+		}
+	}
+
+	return archive, nil
+}
+
+// Module returns the name of the removed module.
+func (a *Archive) Module() string {
+	return a.module
+}
+
+// Types returns the archived types.
+func (a *Archive) Types() []types.LegacyType {
+	legacyTypes := make([]types.LegacyType, 0, len(a.types))
+	for _, t := range a.types {
+		legacyTypes = append(legacyTypes, types.LegacyType{
+			Module:    a.module,
+			TypeUrl:   "/" + string(t.desc.FullName()),
+			Interface: t.iface,
+		})
+	}
+	return legacyTypes
+}
+
+// archivedMessages returns the messages of fd which are the input of a Msg
+// service, or implement cosmos.gov.v1beta1.Content.
+func archivedMessages(fd protoreflect.FileDescriptor) []*archivedType {
+	var archived []*archivedType
+	services := fd.Services()
+	for i := 0; i < services.Len(); i++ {
+		service := services.Get(i)
+		if !protov2.GetExtension(service.Options(), msgv1.E_Service).(bool) {
+			continue
+		}
+		methods := service.Methods()
+		for j := 0; j < methods.Len(); j++ {
+			input := methods.Get(j).Input()
+			if input.ParentFile() != fd || input.Parent() != fd {
+				continue
+			}
+			if !slices.ContainsFunc(archived, func(t *archivedType) bool { return t.desc == input }) {
+				archived = append(archived, &archivedType{iface: types.MsgInterface, desc: input})
+			}
+		}
+	}
+
+	msgs := fd.Messages()
+	for i := 0; i < msgs.Len(); i++ {
+		msg := msgs.Get(i)
+		ifaces := protov2.GetExtension(msg.Options(), cosmos_proto.E_ImplementsInterface).([]string)
+		if slices.Contains(ifaces, types.ContentInterface) {
+			archived = append(archived, &archivedType{iface: types.ContentInterface, desc: msg})
+		}
+	}
+	return archived
+}
+
+// wireDescriptor returns a lenient copy of the layout of fdp, used to check
+// the fields of archived data, and its gzipped FileDescriptorProto for the
+// Descriptor method of the archived messages. Every length-delimited field is
+// declared as bytes, so that the checks never descend into nested messages
+// whose layout changed across releases of the removed module, and the copy
+// has no dependencies.
+func wireDescriptor(fdp *descriptorpb.FileDescriptorProto) (protoreflect.FileDescriptor, []byte, error) {
+	wire := &descriptorpb.FileDescriptorProto{
+		Name:        fdp.Name,
+		Package:     fdp.Package,
+		Syntax:      fdp.Syntax,
+		MessageType: wireMessages(fdp.MessageType),
+	}
+	fd, err := protodesc.NewFile(wire, new(protoregistry.Files))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	bz, err := protov2.Marshal(wire)
+	if err != nil {
+		return nil, nil, err
+	}
+	var buf bytes.Buffer
+	w := gzip.NewWriter(&buf)
+	if _, err := w.Write(bz); err != nil {
+		return nil, nil, err
+	}
+	if err := w.Close(); err != nil {
+		return nil, nil, err
+	}
+	return fd, buf.Bytes(), nil
+}
+
+func wireMessages(msgs []*descriptorpb.DescriptorProto) []*descriptorpb.DescriptorProto {
+	wire := make([]*descriptorpb.DescriptorProto, 0, len(msgs))
+	for _, msg := range msgs {
+		fields := make([]*descriptorpb.FieldDescriptorProto, 0, len(msg.Field))
+		for _, f := range msg.Field {
+			typ := f.GetType()
+			switch typ {
+			case descriptorpb.FieldDescriptorProto_TYPE_STRING, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE:
+				typ = descriptorpb.FieldDescriptorProto_TYPE_BYTES
+			case descriptorpb.FieldDescriptorProto_TYPE_ENUM:
+				typ = descriptorpb.FieldDescriptorProto_TYPE_INT32
+			case descriptorpb.FieldDescriptorProto_TYPE_GROUP:
+				continue
+			}
+			fields = append(fields, &descriptorpb.FieldDescriptorProto{
+				Name:     f.Name,
+				Number:   f.Number,
+				Label:    f.Label,
+				Type:     typ.Enum(),
+				JsonName: f.JsonName,
+			})
+		}
+		wire = append(wire, &descriptorpb.DescriptorProto{
+			Name:  msg.Name,
+			Field: fields,
+			// map entries are turned into plain nested messages
+			NestedType: wireMessages(msg.NestedType),
+		})
+	}
+	return wire
+}
+
+// registerMessageTypes registers msgs and their nested messages in
+// protoregistry.GlobalTypes.
+func registerMessageTypes(msgs protoreflect.MessageDescriptors) error {
+	for i := 0; i < msgs.Len(); i++ {
+		msg := msgs.Get(i)
+		if msg.IsMapEntry() {
+			continue
+		}
+		if err := protoregistry.GlobalTypes.RegisterMessage(dynamicpb.NewMessageType(msg)); err != nil {
+			return err
+		}
+		if err := registerMessageTypes(msg.Messages()); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resolver resolves the archived files being loaded, then the files of the
+// binary.
+type resolver struct {
+	local *protoregistry.Files
+}
+
+func (r resolver) FindFileByPath(path string) (protoreflect.FileDescriptor, error) {
+	if fd, err := r.local.FindFileByPath(path); err == nil {
+		return fd, nil
+	}
+	return gogoproto.HybridResolver.FindFileByPath(path)
+}
+
+func (r resolver) FindDescriptorByName(name protoreflect.FullName) (protoreflect.Descriptor, error) {
+	if desc, err := r.local.FindDescriptorByName(name); err == nil {
+		return desc, nil
+	}
+	return gogoproto.HybridResolver.FindDescriptorByName(name)
+}
+
+// lookupType returns the archived type decoded by the Go type goType, or nil
+// if goType is not bound to an archived type of a.
+func (a *Archive) lookupType(goType reflect.Type) *archivedType {
+	if a == nil {
+		return nil
+	}
+	return a.byGoType[goType]
+}
+
+// proposalType returns the proposal type of an archived proposal content,
+// i.e. its name without the Proposal suffix.
+func proposalType(desc protoreflect.MessageDescriptor) string {
+	return strings.TrimSuffix(string(desc.Name()), "Proposal")
+}
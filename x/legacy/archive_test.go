@@ -0,0 +1,192 @@
+package legacy_test
+
+import (
+	"testing"
+
+	cosmos_proto "github.com/cosmos/cosmos-proto"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos/gaia/v29/app/params"
+	"github.com/cosmos/gaia/v29/x/legacy"
+	"github.com/cosmos/gaia/v29/x/legacy/types"
+)
+
+// archivedFile returns the layout of a removed module, with a Msg service
+// taking MsgPost and a proposal content, TextProposal.
+func archivedFile() *descriptorpb.FileDescriptorProto {
+	str := descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum()
+	optional := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum()
+
+	serviceOpts := &descriptorpb.ServiceOptions{}
+	protov2.SetExtension(serviceOpts, msgv1.E_Service, true)
+	contentOpts := &descriptorpb.MessageOptions{}
+	protov2.SetExtension(contentOpts, cosmos_proto.E_ImplementsInterface, []string{types.ContentInterface})
+
+	return &descriptorpb.FileDescriptorProto{
+		Name:    protov2.String("removed/v1/removed.proto"),
+		Package: protov2.String("removed.v1"),
+		Syntax:  protov2.String("proto3"),
+		MessageType: []*descriptorpb.DescriptorProto{
+			{Name: protov2.String("MsgPost"), Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: protov2.String("author"), Number: protov2.Int32(1), Type: str, Label: optional},
+				{Name: protov2.String("text"), Number: protov2.Int32(2), Type: str, Label: optional},
+			}},
+			{Name: protov2.String("TextProposal"), Options: contentOpts, Field: []*descriptorpb.FieldDescriptorProto{
+				{Name: protov2.String("title"), Number: protov2.Int32(1), Type: str, Label: optional},
+				{Name: protov2.String("description"), Number: protov2.Int32(2), Type: str, Label: optional},
+			}},
+		},
+		Service: []*descriptorpb.ServiceDescriptorProto{{
+			Name:    protov2.String("Msg"),
+			Options: serviceOpts,
+			Method: []*descriptorpb.MethodDescriptorProto{{
+				Name:       protov2.String("Post"),
+				InputType:  protov2.String(".removed.v1.MsgPost"),
+				OutputType: protov2.String(".removed.v1.MsgPost"),
+			}},
+		}},
+	}
+}
+
+// removed binds the Go types of the archived messages to archive.
+type removed struct{}
+
+func (removed) Archive() *legacy.Archive { return archive }
+
+type (
+	msgPost      struct{ removed }
+	textProposal struct{ removed }
+)
+
+// The archived file can only be registered once per process.
+var archive = func() *legacy.Archive {
+	archive, err := legacy.NewArchive("removed", []*descriptorpb.FileDescriptorProto{archivedFile()}, map[protoreflect.FullName]gogoproto.Message{
+		"removed.v1.MsgPost":      &legacy.Message[msgPost]{},
+		"removed.v1.TextProposal": &legacy.Message[textProposal]{},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return archive
+}()
+
+func TestArchiveTypes(t *testing.T) {
+	require.Equal(t, "removed", archive.Module())
+	require.Equal(t, []types.LegacyType{
+		{Module: "removed", TypeUrl: "/removed.v1.MsgPost", Interface: types.MsgInterface},
+		{Module: "removed", TypeUrl: "/removed.v1.TextProposal", Interface: types.ContentInterface},
+	}, archive.Types())
+
+	resp, err := legacy.NewQueryServer(archive).Types(t.Context(), &types.QueryTypesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, archive.Types(), resp.Types)
+}
+
+func TestArchivedMsg(t *testing.T) {
+	encCfg := params.MakeEncodingConfig()
+	legacy.RegisterInterfaces(encCfg.InterfaceRegistry, archive)
+	// registering twice is a no-op
+	legacy.RegisterInterfaces(encCfg.InterfaceRegistry, archive)
+
+	cdc := codec.NewProtoCodec(encCfg.InterfaceRegistry)
+
+	var msg sdk.Msg
+	err := cdc.UnpackAny(&codectypes.Any{
+		TypeUrl: "/removed.v1.MsgPost",
+		// author = "alice", text = "hi"
+		Value: []byte{0x0a, 0x05, 'a', 'l', 'i', 'c', 'e', 0x12, 0x02, 'h', 'i'},
+	}, &msg)
+	require.NoError(t, err)
+	require.IsType(t, &legacy.Message[msgPost]{}, msg)
+
+	bz, err := cdc.MarshalInterfaceJSON(msg)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"@type":"/removed.v1.MsgPost","author":"alice","text":"hi"}`, string(bz))
+
+	var decoded sdk.Msg
+	require.NoError(t, cdc.UnmarshalInterfaceJSON(bz, &decoded))
+	require.IsType(t, msg, decoded)
+	require.Equal(t, msg, decoded)
+
+	require.ErrorContains(t, msg.(sdk.HasValidateBasic).ValidateBasic(), "legacy type removed.v1.MsgPost")
+}
+
+func TestArchivedProposal(t *testing.T) {
+	encCfg := params.MakeEncodingConfig()
+	govv1beta1.RegisterInterfaces(encCfg.InterfaceRegistry)
+	legacy.RegisterInterfaces(encCfg.InterfaceRegistry, archive)
+
+	submitMsg := &govv1beta1.MsgSubmitProposal{
+		Content: &codectypes.Any{
+			TypeUrl: "/removed.v1.TextProposal",
+			// title = "T", description = "D"
+			Value: []byte{0x0a, 0x01, 'T', 0x12, 0x01, 'D'},
+		},
+	}
+	require.NoError(t, submitMsg.UnpackInterfaces(encCfg.InterfaceRegistry))
+
+	content := submitMsg.GetContent()
+	require.Equal(t, "T", content.GetTitle())
+	require.Equal(t, "D", content.GetDescription())
+	require.Equal(t, "removed", content.ProposalRoute())
+	require.Equal(t, "Text", content.ProposalType())
+	require.Error(t, content.ValidateBasic())
+
+	// The archived message is not registered as a proposal content.
+	submitMsg.Content = &codectypes.Any{TypeUrl: "/removed.v1.MsgPost"}
+	require.Error(t, submitMsg.UnpackInterfaces(encCfg.InterfaceRegistry))
+}
+
+func TestNewArchiveBindings(t *testing.T) {
+	// Files already registered are not archived again.
+	archived, err := legacy.NewArchive("removed", []*descriptorpb.FileDescriptorProto{archivedFile()}, nil)
+	require.NoError(t, err)
+	require.Empty(t, archived.Types())
+
+	file := archivedFile()
+	file.Name = protov2.String("bound/v1/bound.proto")
+	file.Package = protov2.String("bound.v1")
+	file.Service[0].Method[0].InputType = protov2.String(".bound.v1.MsgPost")
+	file.Service[0].Method[0].OutputType = protov2.String(".bound.v1.MsgPost")
+
+	for _, tc := range []struct {
+		bindings map[protoreflect.FullName]gogoproto.Message
+		err      string
+	}{{
+		bindings: map[protoreflect.FullName]gogoproto.Message{
+			"bound.v1.TextProposal": &legacy.Message[textProposal]{},
+		},
+		err: "cannot archive bound.v1.MsgPost: no Go type bound to it",
+	}, {
+		bindings: map[protoreflect.FullName]gogoproto.Message{
+			"bound.v1.MsgPost":      &govv1beta1.TextProposal{},
+			"bound.v1.TextProposal": &legacy.Message[textProposal]{},
+		},
+		err: "not a legacy.Message",
+	}, {
+		bindings: map[protoreflect.FullName]gogoproto.Message{
+			"bound.v1.MsgPost":      &legacy.Message[textProposal]{},
+			"bound.v1.TextProposal": &legacy.Message[textProposal]{},
+		},
+		err: "already bound to bound.v1.MsgPost",
+	}} {
+		_, err = legacy.NewArchive("bound", []*descriptorpb.FileDescriptorProto{file}, tc.bindings)
+		require.ErrorContains(t, err, tc.err)
+	}
+
+	// Invalid bindings leave the registries untouched.
+	_, err = protoregistry.GlobalFiles.FindFileByPath("bound/v1/bound.proto")
+	require.ErrorIs(t, err, protoregistry.NotFound)
+}
@@ -0,0 +1,27 @@
+package legacy
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/gaia/v29/x/legacy/types"
+)
+
+func (a AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Types",
+					Use:       "types",
+					Short:     "Query the types of removed modules which the node can still decode",
+					Example:   fmt.Sprintf("$ %s query legacy types", version.AppName),
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,43 @@
+package legacy
+
+import (
+	"reflect"
+
+	gogoproto "github.com/cosmos/gogoproto/proto"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos/gaia/v29/x/legacy/types"
+)
+
+// customRegistrar is satisfied by the concrete *interfaceRegistry in the SDK.
+// It lets us register archived types under explicit typeURLs without relying
+// on proto.MessageName.
+type customRegistrar interface {
+	RegisterCustomTypeURL(iface any, typeURL string, impl gogoproto.Message)
+}
+
+// RegisterInterfaces registers the archived types with the interface registry
+// so that historical on-chain data containing their type URLs can be decoded.
+// It is safe to call multiple times, e.g. when NewGaiaApp is instantiated more
+// than once in a process, as in e2e test setup.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry, archives ...*Archive) {
+	cr, ok := registry.(customRegistrar)
+	if !ok {
+		panic("interface registry does not support RegisterCustomTypeURL; cannot register legacy types")
+	}
+
+	for _, archive := range archives {
+		for _, t := range archive.types {
+			impl := reflect.New(t.goType.Elem()).Interface().(gogoproto.Message)
+			switch t.iface {
+			case types.MsgInterface:
+				cr.RegisterCustomTypeURL((*sdk.Msg)(nil), "/"+string(t.desc.FullName()), impl)
+			case types.ContentInterface:
+				cr.RegisterCustomTypeURL((*govv1beta1.Content)(nil), "/"+string(t.desc.FullName()), impl)
+			}
+		}
+	}
+}
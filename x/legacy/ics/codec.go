@@ -1,76 +1,64 @@
 package ics
 
 import (
-	proto "github.com/cosmos/gogoproto/proto"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
-	sdk "github.com/cosmos/cosmos-sdk/types"
-	govv1beta1 "github.com/cosmos/cosmos-sdk/x/gov/types/v1beta1"
+
+	"github.com/cosmos/gaia/v29/x/legacy"
 )
 
-// customRegistrar is satisfied by the concrete *interfaceRegistry in the SDK.
-// It lets us register stubs under explicit typeURLs without relying on
-// proto.MessageName, which would return "" for unregistered stub types.
-type customRegistrar interface {
-	RegisterCustomTypeURL(iface any, typeURL string, impl proto.Message)
-}
+// ModuleName is the name of the removed ICS provider module.
+const ModuleName = "provider"
 
-// resolve registers the stub in the global proto registry under fqName (so
-// that proto.MessageName works for gRPC reflection) and returns the stub.
-// The check makes this safe to call multiple times (e.g. when NewGaiaApp is
-// instantiated more than once in a process, as in e2e test setup).
-func resolve(fqName string, stub proto.Message) proto.Message {
-	if proto.MessageType(fqName) == nil {
-		proto.RegisterType(stub, fqName)
-	}
-	return stub
-}
+// Archive holds the archived ICS provider messages and proposals.
+var Archive = newArchive()
 
-// RegisterInterfaces registers legacy ICS provider message and proposal stubs
-// with the interface registry so that historical on-chain data containing these
-// type URLs can be decoded after the ICS provider module has been removed.
-func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
-	cr, ok := registry.(customRegistrar)
-	if !ok {
-		panic("interface registry does not support RegisterCustomTypeURL; cannot register legacy ICS stubs")
-	}
+// provider binds the Go types of the archived ICS provider messages and
+// proposals to Archive.
+type provider struct{}
 
-	const pkg = "interchain_security.ccv.provider.v1."
+// Archive implements the legacy.Type interface.
+func (provider) Archive() *legacy.Archive { return Archive }
 
-	msgs := []struct {
-		name string
-		stub proto.Message
-	}{
-		{"MsgAssignConsumerKey", &MsgAssignConsumerKey{}},
-		{"MsgConsumerAddition", &MsgConsumerAddition{}},
-		{"MsgConsumerRemoval", &MsgConsumerRemoval{}},
-		{"MsgConsumerModification", &MsgConsumerModification{}},
-		{"MsgCreateConsumer", &MsgCreateConsumer{}},
-		{"MsgUpdateConsumer", &MsgUpdateConsumer{}},
-		{"MsgRemoveConsumer", &MsgRemoveConsumer{}},
-		{"MsgChangeRewardDenoms", &MsgChangeRewardDenoms{}},
-		{"MsgUpdateParams", &MsgUpdateParams{}},
-		{"MsgSubmitConsumerMisbehaviour", &MsgSubmitConsumerMisbehaviour{}},
-		{"MsgSubmitConsumerDoubleVoting", &MsgSubmitConsumerDoubleVoting{}},
-		{"MsgOptIn", &MsgOptIn{}},
-		{"MsgOptOut", &MsgOptOut{}},
-		{"MsgSetConsumerCommissionRate", &MsgSetConsumerCommissionRate{}},
-	}
-	for _, m := range msgs {
-		cr.RegisterCustomTypeURL((*sdk.Msg)(nil), "/"+pkg+m.name, resolve(pkg+m.name, m.stub))
+func newArchive() *legacy.Archive {
+	archive, err := legacy.NewArchive(
+		ModuleName,
+		[]*descriptorpb.FileDescriptorProto{fileDescriptor()},
+		map[protoreflect.FullName]gogoproto.Message{
+			legacyPkg + ".MsgAssignConsumerKey":          &MsgAssignConsumerKey{},
+			legacyPkg + ".MsgConsumerAddition":           &MsgConsumerAddition{},
+			legacyPkg + ".MsgConsumerRemoval":            &MsgConsumerRemoval{},
+			legacyPkg + ".MsgConsumerModification":       &MsgConsumerModification{},
+			legacyPkg + ".MsgCreateConsumer":             &MsgCreateConsumer{},
+			legacyPkg + ".MsgUpdateConsumer":             &MsgUpdateConsumer{},
+			legacyPkg + ".MsgRemoveConsumer":             &MsgRemoveConsumer{},
+			legacyPkg + ".MsgChangeRewardDenoms":         &MsgChangeRewardDenoms{},
+			legacyPkg + ".MsgUpdateParams":               &MsgUpdateParams{},
+			legacyPkg + ".MsgSubmitConsumerMisbehaviour": &MsgSubmitConsumerMisbehaviour{},
+			legacyPkg + ".MsgSubmitConsumerDoubleVoting": &MsgSubmitConsumerDoubleVoting{},
+			legacyPkg + ".MsgOptIn":                      &MsgOptIn{},
+			legacyPkg + ".MsgOptOut":                     &MsgOptOut{},
+			legacyPkg + ".MsgSetConsumerCommissionRate":  &MsgSetConsumerCommissionRate{},
+			legacyPkg + ".ConsumerAdditionProposal":      &ConsumerAdditionProposal{},
+			legacyPkg + ".ConsumerRemovalProposal":       &ConsumerRemovalProposal{},
+			legacyPkg + ".ConsumerModificationProposal":  &ConsumerModificationProposal{},
+			legacyPkg + ".ChangeRewardDenomsProposal":    &ChangeRewardDenomsProposal{},
+			legacyPkg + ".EquivocationProposal":          &EquivocationProposal{},
+		},
+	)
+	if err != nil {
+		panic("legacyics: failed to archive the ICS provider types: " + err.Error())
 	}
+	return archive
+}
 
-	proposals := []struct {
-		name string
-		stub proto.Message
-	}{
-		{"ConsumerAdditionProposal", &ConsumerAdditionProposal{}},
-		{"ConsumerRemovalProposal", &ConsumerRemovalProposal{}},
-		{"ConsumerModificationProposal", &ConsumerModificationProposal{}},
-		{"ChangeRewardDenomsProposal", &ChangeRewardDenomsProposal{}},
-		{"EquivocationProposal", &EquivocationProposal{}},
-	}
-	for _, p := range proposals {
-		cr.RegisterCustomTypeURL((*govv1beta1.Content)(nil), "/"+pkg+p.name, resolve(pkg+p.name, p.stub))
-	}
+// RegisterInterfaces registers the legacy ICS provider messages and proposals
+// with the interface registry so that historical on-chain data containing
+// these type URLs can be decoded after the ICS provider module has been
+// removed.
+func RegisterInterfaces(registry codectypes.InterfaceRegistry) {
+	legacy.RegisterInterfaces(registry, Archive)
 }
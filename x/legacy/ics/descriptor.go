@@ -1,94 +1,72 @@
 package ics
 
 import (
-	"bytes"
-	"compress/gzip"
-	"fmt"
-
+	cosmos_proto "github.com/cosmos/cosmos-proto"
 	protov2 "google.golang.org/protobuf/proto"
-	"google.golang.org/protobuf/reflect/protodesc"
-	"google.golang.org/protobuf/reflect/protoregistry"
 	"google.golang.org/protobuf/types/descriptorpb"
-	"google.golang.org/protobuf/types/dynamicpb"
+	"google.golang.org/protobuf/types/known/durationpb"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	msgv1 "cosmossdk.io/api/cosmos/msg/v1"
-)
 
-// fileDescBytes holds a gzip-compressed FileDescriptorProto for all legacy ICS
-// provider message stubs. It is used to satisfy the Descriptor() ([]byte,[]int)
-// interface required by the Cosmos SDK's unknownproto package when decoding
-// transactions and governance proposals that reference these type URLs.
-//
-// Each message declares its actual field numbers with wire-type-compatible types
-// so that the unknownproto field checker does not reject stored historical data.
-// All string/bytes/message fields are declared as TYPE_BYTES (wire type 2).
-// Integer/bool fields are declared as TYPE_INT64 or TYPE_UINT32/UINT64/BOOL
-// (wire type 0) to match the actual varint encoding on-chain.
-var fileDescBytes []byte
+	legacytypes "github.com/cosmos/gaia/v29/x/legacy/types"
+)
 
-// Message indices within fileDescBytes (order must match MessageType slice in init).
 const (
-	idxMsgAssignConsumerKey          = 0
-	idxMsgConsumerAddition           = 1
-	idxMsgConsumerRemoval            = 2
-	idxMsgConsumerModification       = 3
-	idxMsgCreateConsumer             = 4
-	idxMsgUpdateConsumer             = 5
-	idxMsgRemoveConsumer             = 6
-	idxMsgChangeRewardDenoms         = 7
-	idxMsgUpdateParams               = 8
-	idxMsgSubmitConsumerMisbehaviour = 9
-	idxMsgSubmitConsumerDoubleVoting = 10
-	idxMsgOptIn                      = 11
-	idxMsgOptOut                     = 12
-	idxMsgSetConsumerCommissionRate  = 13
-
-	// Governance proposal types (legacy gov v1beta1 Content)
-	idxConsumerAdditionProposal     = 14
-	idxConsumerRemovalProposal      = 15
-	idxConsumerModificationProposal = 16
-	idxChangeRewardDenomsProposal   = 17
-	idxEquivocationProposal         = 18
+	legacyPkg  = "interchain_security.ccv.provider.v1"
+	legacyFile = "interchain_security/ccv/provider/v1/legacy_stubs.proto"
 )
 
-// fB returns a BYTES-typed field descriptor (wire type 2: string, bytes, message).
-func fB(num int32) *descriptorpb.FieldDescriptorProto {
-	name := fmt.Sprintf("f%d", num)
-	typ := descriptorpb.FieldDescriptorProto_TYPE_BYTES
+// field returns an optional field descriptor of the given scalar type.
+func field(num int32, name string, typ descriptorpb.FieldDescriptorProto_Type) *descriptorpb.FieldDescriptorProto {
 	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
 	return &descriptorpb.FieldDescriptorProto{Name: &name, Number: &num, Type: &typ, Label: &label}
 }
 
+// fStr returns a STRING-typed field descriptor (wire type 2).
+func fStr(num int32, name string) *descriptorpb.FieldDescriptorProto {
+	return field(num, name, descriptorpb.FieldDescriptorProto_TYPE_STRING)
+}
+
+// fB returns a BYTES-typed field descriptor (wire type 2). It is also used
+// for the nested IBC and CometBFT messages, which are kept opaque.
+func fB(num int32, name string) *descriptorpb.FieldDescriptorProto {
+	return field(num, name, descriptorpb.FieldDescriptorProto_TYPE_BYTES)
+}
+
 // fI returns an INT64-typed field descriptor (wire type 0: varint).
-func fI(num int32) *descriptorpb.FieldDescriptorProto {
-	name := fmt.Sprintf("f%d", num)
-	typ := descriptorpb.FieldDescriptorProto_TYPE_INT64
-	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
-	return &descriptorpb.FieldDescriptorProto{Name: &name, Number: &num, Type: &typ, Label: &label}
+func fI(num int32, name string) *descriptorpb.FieldDescriptorProto {
+	return field(num, name, descriptorpb.FieldDescriptorProto_TYPE_INT64)
 }
 
 // fU32 returns a UINT32-typed field descriptor (wire type 0: varint).
-func fU32(num int32) *descriptorpb.FieldDescriptorProto {
-	name := fmt.Sprintf("f%d", num)
-	typ := descriptorpb.FieldDescriptorProto_TYPE_UINT32
-	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
-	return &descriptorpb.FieldDescriptorProto{Name: &name, Number: &num, Type: &typ, Label: &label}
+func fU32(num int32, name string) *descriptorpb.FieldDescriptorProto {
+	return field(num, name, descriptorpb.FieldDescriptorProto_TYPE_UINT32)
 }
 
 // fU64 returns a UINT64-typed field descriptor (wire type 0: varint).
-func fU64(num int32) *descriptorpb.FieldDescriptorProto {
-	name := fmt.Sprintf("f%d", num)
-	typ := descriptorpb.FieldDescriptorProto_TYPE_UINT64
-	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
-	return &descriptorpb.FieldDescriptorProto{Name: &name, Number: &num, Type: &typ, Label: &label}
+func fU64(num int32, name string) *descriptorpb.FieldDescriptorProto {
+	return field(num, name, descriptorpb.FieldDescriptorProto_TYPE_UINT64)
 }
 
 // fBool returns a BOOL-typed field descriptor (wire type 0: varint).
-func fBool(num int32) *descriptorpb.FieldDescriptorProto {
-	name := fmt.Sprintf("f%d", num)
-	typ := descriptorpb.FieldDescriptorProto_TYPE_BOOL
-	label := descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL
-	return &descriptorpb.FieldDescriptorProto{Name: &name, Number: &num, Type: &typ, Label: &label}
+func fBool(num int32, name string) *descriptorpb.FieldDescriptorProto {
+	return field(num, name, descriptorpb.FieldDescriptorProto_TYPE_BOOL)
+}
+
+// fMsg returns a MESSAGE-typed field descriptor (wire type 2) of the fully
+// qualified typeName.
+func fMsg(num int32, name, typeName string) *descriptorpb.FieldDescriptorProto {
+	f := field(num, name, descriptorpb.FieldDescriptorProto_TYPE_MESSAGE)
+	f.TypeName = &typeName
+	return f
+}
+
+// repeated marks f as a repeated field.
+func repeated(f *descriptorpb.FieldDescriptorProto) *descriptorpb.FieldDescriptorProto {
+	label := descriptorpb.FieldDescriptorProto_LABEL_REPEATED
+	f.Label = &label
+	return f
 }
 
 // msgServiceAnnotation returns a *descriptorpb.ServiceOptions with the
@@ -102,143 +80,195 @@ func msgServiceAnnotation() *descriptorpb.ServiceOptions {
 	return opts
 }
 
-func init() {
+// contentAnnotation returns a *descriptorpb.MessageOptions with the
+// cosmos_proto.implements_interface = "cosmos.gov.v1beta1.Content" extension
+// set, which makes x/legacy register the message as a proposal content.
+func contentAnnotation() *descriptorpb.MessageOptions {
+	opts := &descriptorpb.MessageOptions{}
+	protov2.SetExtension(opts, cosmos_proto.E_ImplementsInterface, []string{legacytypes.ContentInterface})
+	return opts
+}
+
+// fileDescriptor returns the layout of the legacy ICS provider messages and
+// proposals, with the field names and types of the original ICS provider
+// protos.
+func fileDescriptor() *descriptorpb.FileDescriptorProto {
 	name := func(s string) *string { return &s }
+	typ := func(s string) string { return "." + legacyPkg + "." + s }
+	const (
+		timestamp = ".google.protobuf.Timestamp"
+		duration  = ".google.protobuf.Duration"
+	)
 
 	fdp := &descriptorpb.FileDescriptorProto{
-		Name:    name("interchain_security/ccv/provider/v1/legacy_stubs.proto"),
-		Package: name("interchain_security.ccv.provider.v1"),
+		Name:    name(legacyFile),
+		Package: name(legacyPkg),
 		Syntax:  name("proto3"),
+		Dependency: []string{
+			timestamppb.File_google_protobuf_timestamp_proto.Path(),
+			durationpb.File_google_protobuf_duration_proto.Path(),
+		},
 		MessageType: []*descriptorpb.DescriptorProto{
-			// 0 — MsgAssignConsumerKey: all string fields (wire type 2)
+			// MsgAssignConsumerKey
 			{Name: name("MsgAssignConsumerKey"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4), fB(5),
+				fStr(1, "chain_id"), fStr(2, "provider_addr"), fStr(3, "consumer_key"), fStr(4, "signer"),
+				fStr(5, "consumer_id"),
 			}},
-			// 1 — MsgConsumerAddition: string/bytes/message fields + int64/uint32/uint64/bool varints
+			// MsgConsumerAddition
 			{Name: name("MsgConsumerAddition"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4), fB(5), fB(6), fB(7), fB(8), fB(9),
-				fI(10), fI(11), fB(12), fU32(13), fU32(14), fU32(15),
-				fB(16), fB(17), fB(18), fU64(19), fBool(20),
+				fStr(1, "chain_id"), fMsg(2, "initial_height", typ("Height")), fB(3, "genesis_hash"), fB(4, "binary_hash"),
+				fMsg(5, "spawn_time", timestamp), fMsg(6, "unbonding_period", duration),
+				fMsg(7, "ccv_timeout_period", duration), fMsg(8, "transfer_timeout_period", duration),
+				fStr(9, "consumer_redistribution_fraction"), fI(10, "blocks_per_distribution_transmission"),
+				fI(11, "historical_entries"), fStr(12, "distribution_transmission_channel"), fU32(13, "top_N"),
+				fU32(14, "validators_power_cap"), fU32(15, "validator_set_cap"), repeated(fStr(16, "allowlist")),
+				repeated(fStr(17, "denylist")), fStr(18, "authority"), fU64(19, "min_stake"), fBool(20, "allow_inactive_vals"),
 			}},
-			// 2 — MsgConsumerRemoval: string + Timestamp + string (all wire type 2)
+			// MsgConsumerRemoval
 			{Name: name("MsgConsumerRemoval"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3),
+				fStr(1, "chain_id"), fMsg(2, "stop_time", timestamp), fStr(3, "authority"),
 			}},
-			// 3 — MsgConsumerModification: string fields + uint32/uint64/bool varints
+			// MsgConsumerModification
 			{Name: name("MsgConsumerModification"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fU32(4), fU32(5), fU32(6),
-				fB(7), fB(8), fB(9), fU64(10), fBool(11),
+				fStr(1, "title"), fStr(2, "description"), fStr(3, "chain_id"), fU32(4, "top_N"),
+				fU32(5, "validators_power_cap"), fU32(6, "validator_set_cap"), repeated(fStr(7, "allowlist")),
+				repeated(fStr(8, "denylist")), fStr(9, "authority"), fU64(10, "min_stake"), fBool(11, "allow_inactive_vals"),
 			}},
-			// 4 — MsgCreateConsumer: all string/message fields (wire type 2)
+			// MsgCreateConsumer
 			{Name: name("MsgCreateConsumer"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4), fB(5), fB(6), fB(7),
+				fStr(1, "submitter"), fStr(2, "chain_id"), fMsg(3, "metadata", typ("ConsumerMetadata")),
+				fMsg(4, "initialization_parameters", typ("ConsumerInitializationParameters")),
+				fMsg(5, "power_shaping_parameters", typ("PowerShapingParameters")),
+				fMsg(6, "allowlisted_reward_denoms", typ("AllowlistedRewardDenoms")),
+				fMsg(7, "infraction_parameters", typ("InfractionParameters")),
 			}},
-			// 5 — MsgUpdateConsumer: all string/message fields (wire type 2)
+			// MsgUpdateConsumer
 			{Name: name("MsgUpdateConsumer"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4), fB(5), fB(6), fB(7), fB(8), fB(9),
+				fStr(1, "owner"), fStr(2, "consumer_id"), fStr(3, "new_owner_address"),
+				fMsg(4, "metadata", typ("ConsumerMetadata")),
+				fMsg(5, "initialization_parameters", typ("ConsumerInitializationParameters")),
+				fMsg(6, "power_shaping_parameters", typ("PowerShapingParameters")),
+				fMsg(7, "allowlisted_reward_denoms", typ("AllowlistedRewardDenoms")),
+				fStr(8, "new_chain_id"), fMsg(9, "infraction_parameters", typ("InfractionParameters")),
 			}},
-			// 6 — MsgRemoveConsumer: string fields (wire type 2)
+			// MsgRemoveConsumer
 			{Name: name("MsgRemoveConsumer"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2),
+				fStr(1, "consumer_id"), fStr(2, "owner"),
 			}},
-			// 7 — MsgChangeRewardDenoms: repeated string + string (all wire type 2)
+			// MsgChangeRewardDenoms
 			{Name: name("MsgChangeRewardDenoms"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3),
+				repeated(fStr(1, "denoms_to_add")), repeated(fStr(2, "denoms_to_remove")), fStr(3, "authority"),
 			}},
-			// 8 — MsgUpdateParams: string + message (all wire type 2)
+			// MsgUpdateParams: params is an opaque provider Params
 			{Name: name("MsgUpdateParams"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2),
+				fStr(1, "authority"), fB(2, "params"),
 			}},
-			// 9 — MsgSubmitConsumerMisbehaviour: string + message + string (wire type 2)
+			// MsgSubmitConsumerMisbehaviour: misbehaviour is an opaque
+			// ibc.lightclients.tendermint.v1.Misbehaviour
 			{Name: name("MsgSubmitConsumerMisbehaviour"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3),
+				fStr(1, "submitter"), fB(2, "misbehaviour"), fStr(3, "consumer_id"),
 			}},
-			// 10 — MsgSubmitConsumerDoubleVoting: string + message + message + string (wire type 2)
+			// MsgSubmitConsumerDoubleVoting: the evidence and header are
+			// opaque CometBFT and IBC messages
 			{Name: name("MsgSubmitConsumerDoubleVoting"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4),
+				fStr(1, "submitter"), fB(2, "duplicate_vote_evidence"), fB(3, "infraction_block_header"),
+				fStr(4, "consumer_id"),
 			}},
-			// 11 — MsgOptIn: all string fields (wire type 2)
+			// MsgOptIn
 			{Name: name("MsgOptIn"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4), fB(5),
+				fStr(1, "chain_id"), fStr(2, "provider_addr"), fStr(3, "consumer_key"), fStr(4, "signer"),
+				fStr(5, "consumer_id"),
 			}},
-			// 12 — MsgOptOut: all string fields (wire type 2)
+			// MsgOptOut
 			{Name: name("MsgOptOut"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4),
+				fStr(1, "chain_id"), fStr(2, "provider_addr"), fStr(3, "signer"), fStr(4, "consumer_id"),
 			}},
-			// 13 — MsgSetConsumerCommissionRate: all string fields (wire type 2)
+			// MsgSetConsumerCommissionRate
 			{Name: name("MsgSetConsumerCommissionRate"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4), fB(5),
+				fStr(1, "provider_addr"), fStr(2, "chain_id"), fStr(3, "rate"), fStr(4, "signer"),
+				fStr(5, "consumer_id"),
 			}},
 
-			// 14 — ConsumerAdditionProposal (legacy gov v1beta1 Content)
-			// Fields per interchain_security/ccv/provider/v1/provider.proto:
-			//   1  title                             string
-			//   2  description                       string
-			//   3  chain_id                          string
-			//   4  initial_height                    ibc.core.client.v1.Height (message)
-			//   5  genesis_hash                      bytes
-			//   6  binary_hash                       bytes
-			//   7  spawn_time                        google.protobuf.Timestamp (message)
-			//   8  unbonding_period                  google.protobuf.Duration  (message)
-			//   9  ccv_timeout_period                google.protobuf.Duration  (message)
-			//  10  transfer_timeout_period           google.protobuf.Duration  (message)
-			//  11  consumer_redistribution_fraction  string
-			//  12  blocks_per_distribution_transmission int64
-			//  13  historical_entries                int64
-			//  14  distribution_transmission_channel string
-			//  15  top_N                             uint32
-			//  16  validators_power_cap              uint32
-			//  17  validator_set_cap                 uint32
-			//  18  allowlist                         repeated string
-			//  19  denylist                          repeated string
-			//  20  min_stake                         uint64
-			//  21  allow_inactive_vals               bool
-			{Name: name("ConsumerAdditionProposal"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4), fB(5), fB(6), fB(7), fB(8), fB(9), fB(10),
-				fB(11), fI(12), fI(13), fB(14), fU32(15), fU32(16), fU32(17),
-				fB(18), fB(19), fU64(20), fBool(21),
+			// ConsumerAdditionProposal (legacy gov v1beta1 Content)
+			{Name: name("ConsumerAdditionProposal"), Options: contentAnnotation(), Field: []*descriptorpb.FieldDescriptorProto{
+				fStr(1, "title"), fStr(2, "description"), fStr(3, "chain_id"), fMsg(4, "initial_height", typ("Height")),
+				fB(5, "genesis_hash"), fB(6, "binary_hash"), fMsg(7, "spawn_time", timestamp),
+				fMsg(8, "unbonding_period", duration), fMsg(9, "ccv_timeout_period", duration),
+				fMsg(10, "transfer_timeout_period", duration), fStr(11, "consumer_redistribution_fraction"),
+				fI(12, "blocks_per_distribution_transmission"), fI(13, "historical_entries"),
+				fStr(14, "distribution_transmission_channel"), fU32(15, "top_N"), fU32(16, "validators_power_cap"),
+				fU32(17, "validator_set_cap"), repeated(fStr(18, "allowlist")), repeated(fStr(19, "denylist")),
+				fU64(20, "min_stake"), fBool(21, "allow_inactive_vals"),
 			}},
-
-			// 15 — ConsumerRemovalProposal
-			//   1  title        string
-			//   2  description  string
-			//   3  chain_id     string
-			//   4  stop_time    google.protobuf.Timestamp (message)
-			{Name: name("ConsumerRemovalProposal"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4),
+			// ConsumerRemovalProposal
+			{Name: name("ConsumerRemovalProposal"), Options: contentAnnotation(), Field: []*descriptorpb.FieldDescriptorProto{
+				fStr(1, "title"), fStr(2, "description"), fStr(3, "chain_id"), fMsg(4, "stop_time", timestamp),
 			}},
-
-			// 16 — ConsumerModificationProposal
-			//   1  title                string
-			//   2  description          string
-			//   3  chain_id             string
-			//   4  top_N                uint32
-			//   5  validators_power_cap uint32
-			//   6  validator_set_cap    uint32
-			//   7  allowlist            repeated string
-			//   8  denylist             repeated string
-			//   9  min_stake            uint64
-			//  10  allow_inactive_vals  bool
-			{Name: name("ConsumerModificationProposal"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fU32(4), fU32(5), fU32(6),
-				fB(7), fB(8), fU64(9), fBool(10),
+			// ConsumerModificationProposal
+			{Name: name("ConsumerModificationProposal"), Options: contentAnnotation(), Field: []*descriptorpb.FieldDescriptorProto{
+				fStr(1, "title"), fStr(2, "description"), fStr(3, "chain_id"), fU32(4, "top_N"),
+				fU32(5, "validators_power_cap"), fU32(6, "validator_set_cap"), repeated(fStr(7, "allowlist")),
+				repeated(fStr(8, "denylist")), fU64(9, "min_stake"), fBool(10, "allow_inactive_vals"),
+			}},
+			// ChangeRewardDenomsProposal
+			{Name: name("ChangeRewardDenomsProposal"), Options: contentAnnotation(), Field: []*descriptorpb.FieldDescriptorProto{
+				fStr(1, "title"), fStr(2, "description"), repeated(fStr(3, "denoms_to_add")),
+				repeated(fStr(4, "denoms_to_remove")),
+			}},
+			// EquivocationProposal
+			{Name: name("EquivocationProposal"), Options: contentAnnotation(), Field: []*descriptorpb.FieldDescriptorProto{
+				fStr(1, "title"), fStr(2, "description"), repeated(fMsg(3, "equivocations", typ("Equivocation"))),
 			}},
 
-			// 17 — ChangeRewardDenomsProposal
-			//   1  title            string
-			//   2  description      string
-			//   3  denoms_to_add    repeated string
-			//   4  denoms_to_remove repeated string
-			{Name: name("ChangeRewardDenomsProposal"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3), fB(4),
+			// Nested messages of the types above. ibc.core.client.v1.Height and
+			// cosmos.evidence.v1beta1.Equivocation are declared in this package so
+			// that the file does not depend on gogoproto registered files.
+			{Name: name("Height"), Field: []*descriptorpb.FieldDescriptorProto{
+				fU64(1, "revision_number"), fU64(2, "revision_height"),
+			}},
+			{Name: name("Equivocation"), Field: []*descriptorpb.FieldDescriptorProto{
+				fI(1, "height"), fMsg(2, "time", timestamp), fI(3, "power"), fStr(4, "consensus_address"),
+			}},
+			{Name: name("ConsumerMetadata"), Field: []*descriptorpb.FieldDescriptorProto{
+				fStr(1, "name"), fStr(2, "description"), fStr(3, "metadata"),
+			}},
+			{Name: name("ConsumerInitializationParameters"), Field: []*descriptorpb.FieldDescriptorProto{
+				fMsg(1, "initial_height", typ("Height")), fB(2, "genesis_hash"), fB(3, "binary_hash"),
+				fMsg(4, "spawn_time", timestamp), fMsg(5, "unbonding_period", duration),
+				fMsg(6, "ccv_timeout_period", duration), fMsg(7, "transfer_timeout_period", duration),
+				fStr(8, "consumer_redistribution_fraction"), fI(9, "blocks_per_distribution_transmission"),
+				fI(10, "historical_entries"), fStr(11, "distribution_transmission_channel"), fStr(12, "connection_id"),
+			}},
+			{Name: name("PowerShapingParameters"), Field: []*descriptorpb.FieldDescriptorProto{
+				fU32(1, "top_N"), fU32(2, "validators_power_cap"), fU32(3, "validator_set_cap"),
+				repeated(fStr(4, "allowlist")), repeated(fStr(5, "denylist")), fU64(6, "min_stake"),
+				fBool(7, "allow_inactive_vals"), repeated(fStr(8, "prioritylist")),
+			}},
+			{Name: name("AllowlistedRewardDenoms"), Field: []*descriptorpb.FieldDescriptorProto{
+				repeated(fStr(1, "denoms")),
+			}},
+			{Name: name("InfractionParameters"), Field: []*descriptorpb.FieldDescriptorProto{
+				fMsg(1, "double_sign", typ("SlashJailParameters")), fMsg(2, "downtime", typ("SlashJailParameters")),
+			}},
+			{Name: name("SlashJailParameters"), Field: []*descriptorpb.FieldDescriptorProto{
+				fMsg(1, "jail_duration", duration), fStr(2, "slash_fraction"), fBool(3, "tombstone"),
 			}},
 
-			// 18 — EquivocationProposal
-			//   1  title          string
-			//   2  description    string
-			//   3  equivocations  repeated cosmos.evidence.v1beta1.Equivocation (message)
-			{Name: name("EquivocationProposal"), Field: []*descriptorpb.FieldDescriptorProto{
-				fB(1), fB(2), fB(3),
+			// Values of the provider store, see DecodeProviderStoreEntry.
+			// tendermint.crypto.PublicKey is declared in this package, with
+			// its oneof flattened to plain fields of the same JSON.
+			{Name: name("ConsumerIds"), Field: []*descriptorpb.FieldDescriptorProto{
+				repeated(fStr(1, "ids")),
+			}},
+			{Name: name("AddressList"), Field: []*descriptorpb.FieldDescriptorProto{
+				repeated(fB(1, "addresses")),
+			}},
+			{Name: name("ConsensusValidator"), Field: []*descriptorpb.FieldDescriptorProto{
+				fB(1, "provider_cons_addr"), fI(2, "power"), fMsg(3, "public_key", typ("PublicKey")),
+				fI(4, "join_height"),
+			}},
+			{Name: name("PublicKey"), Field: []*descriptorpb.FieldDescriptorProto{
+				fB(1, "ed25519"), fB(2, "secp256k1"),
 			}},
 		},
 		// Tx service descriptor: required for proto registry completeness and
@@ -279,37 +309,5 @@ func init() {
 		},
 	}
 
-	b, err := protov2.Marshal(fdp)
-	if err != nil {
-		panic("legacyics: failed to marshal file descriptor: " + err.Error())
-	}
-
-	var buf bytes.Buffer
-	w := gzip.NewWriter(&buf)
-	if _, err := w.Write(b); err != nil {
-		panic("legacyics: failed to gzip file descriptor: " + err.Error())
-	}
-	if err := w.Close(); err != nil {
-		panic("legacyics: failed to close gzip writer: " + err.Error())
-	}
-	fileDescBytes = buf.Bytes()
-
-	// Register with the protov2 registries so that the aminojson encoder
-	// (used for the proposals query response marshaling) can resolve these
-	// type URLs via protoregistry.GlobalTypes / GlobalFiles.
-	fd, err := protodesc.NewFile(fdp, protoregistry.GlobalFiles)
-	if err != nil {
-		panic("legacyics: failed to build protov2 file descriptor: " + err.Error())
-	}
-	if err := protoregistry.GlobalFiles.RegisterFile(fd); err != nil {
-		// "already registered" is harmless, happens if two init paths run.
-		_ = err
-	}
-	msgs := fd.Messages()
-	for i := 0; i < msgs.Len(); i++ {
-		mt := dynamicpb.NewMessageType(msgs.Get(i))
-		if err := protoregistry.GlobalTypes.RegisterMessage(mt); err != nil {
-			_ = err
-		}
-	}
+	return fdp
 }
@@ -10,8 +10,11 @@ import (
 	"testing"
 
 	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/types/known/anypb"
 
+	govv1beta1api "cosmossdk.io/api/cosmos/gov/v1beta1"
 	"cosmossdk.io/math"
+	"cosmossdk.io/x/tx/signing/aminojson"
 
 	"github.com/cosmos/cosmos-sdk/codec"
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
@@ -191,9 +194,9 @@ func TestRoundTripMsgUpdateConsumerTxDecode(t *testing.T) {
 // TestTxJSONDecoderWithRealICSFields reproduces the error seen when running
 // `gaiad tx broadcast` against a build with ICS removed. The JSON transaction
 // was originally built with the real ICS proto (which includes rich field
-// names like "allowlisted_reward_denoms") and then signed. Without the
-// UnmarshalJSONPB no-op on stubMsg, the SDK's jsonpb unmarshaler would fail
-// with "unknown field %q in ics.MsgCreateConsumer" because AllowUnknownFields
+// names like "allowlisted_reward_denoms") and then signed. Without
+// UnmarshalJSONPB on the stubs, the SDK's jsonpb unmarshaler would fail with
+// "unknown field %q in ics.MsgCreateConsumer" because AllowUnknownFields
 // defaults to false and the stub registers no proto-tagged fields.
 func TestTxJSONDecoderWithRealICSFields(t *testing.T) {
 	encCfg := params.MakeEncodingConfig()
@@ -552,3 +555,103 @@ func TestLegacyICSProposalSubmitRejected(t *testing.T) {
 		)
 	}
 }
+
+// ---------------------------------------------------------------------------
+// Test 7 -- Legacy ICS field values survive decoding
+// ---------------------------------------------------------------------------
+
+// TestLegacyICSBinaryRoundTrip verifies that the stubs keep the original
+// bytes, so that re-encoding a historical proposal (e.g. in a gRPC query
+// response) does not drop its content.
+func TestLegacyICSBinaryRoundTrip(t *testing.T) {
+	encCfg := params.MakeEncodingConfig()
+	govv1beta1.RegisterInterfaces(encCfg.InterfaceRegistry)
+	ics.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	cdc := codec.NewProtoCodec(encCfg.InterfaceRegistry)
+
+	var content govv1beta1.Content
+	err := cdc.UnpackAny(&codectypes.Any{
+		TypeUrl: "/interchain_security.ccv.provider.v1.ConsumerAdditionProposal",
+		Value:   encodeConsumerAdditionProposal(),
+	}, &content)
+	require.NoError(t, err)
+
+	bz, err := cdc.Marshal(content.(*ics.ConsumerAdditionProposal))
+	require.NoError(t, err)
+	require.Equal(t, encodeConsumerAdditionProposal(), bz)
+}
+
+// TestLegacyICSJSONShowsFields verifies that the JSON of a historical legacy
+// ICS proposal, as returned by queries, contains its original field values.
+func TestLegacyICSJSONShowsFields(t *testing.T) {
+	encCfg := params.MakeEncodingConfig()
+	govv1beta1.RegisterInterfaces(encCfg.InterfaceRegistry)
+	ics.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	cdc := codec.NewProtoCodec(encCfg.InterfaceRegistry)
+
+	submitMsg := &govv1beta1.MsgSubmitProposal{
+		Content: &codectypes.Any{
+			TypeUrl: "/interchain_security.ccv.provider.v1.ConsumerAdditionProposal",
+			Value:   encodeConsumerAdditionProposal(),
+		},
+		Proposer: "cosmos1mrwtsv7p53k90ey2nej4glsv3gphujkh8fr0mx",
+	}
+	require.NoError(t, submitMsg.UnpackInterfaces(encCfg.InterfaceRegistry))
+
+	bz, err := cdc.MarshalJSON(submitMsg)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"@type":"/interchain_security.ccv.provider.v1.ConsumerAdditionProposal"`)
+	require.Contains(t, string(bz), `"chain_id":"consumer-1"`)
+	require.Contains(t, string(bz), `"initial_height":{"revision_number":"1","revision_height":"1"}`)
+	require.Contains(t, string(bz), `"spawn_time":"2023-11-14T22:13:20Z"`)
+	require.Contains(t, string(bz), `"unbonding_period":"1728000s"`)
+	require.Contains(t, string(bz), `"top_N":67`)
+	require.Contains(t, string(bz), `"allowlist":["cosmosvalcons12m5td27rwwy95drgk53w9pfhlxqqguqmlfph2g"]`)
+
+	// The JSON decodes back into the same bytes.
+	var decoded govv1beta1.MsgSubmitProposal
+	require.NoError(t, cdc.UnmarshalJSON(bz, &decoded))
+	require.NoError(t, decoded.UnpackInterfaces(encCfg.InterfaceRegistry))
+	require.Equal(t, bz, cdc.MustMarshalJSON(&decoded))
+}
+
+// TestLegacyICSAminoJSONShowsFields verifies that the amino JSON encoder, used
+// for SIGN_MODE_LEGACY_AMINO_JSON, resolves the legacy ICS types and renders
+// their original field values.
+func TestLegacyICSAminoJSONShowsFields(t *testing.T) {
+	submitMsg := &govv1beta1api.MsgSubmitProposal{
+		Content: &anypb.Any{
+			TypeUrl: "/interchain_security.ccv.provider.v1.ConsumerAdditionProposal",
+			Value:   encodeConsumerAdditionProposal(),
+		},
+	}
+
+	bz, err := aminojson.NewEncoder(aminojson.EncoderOptions{}).Marshal(submitMsg)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"chain_id":"consumer-1"`)
+	require.Contains(t, string(bz), `"top_N":67`)
+}
+
+// TestLegacyICSJSONMismatchedLayout verifies that data which does not match
+// the original layout of its message is still rendered, with its fields
+// decoded by wire type.
+func TestLegacyICSJSONMismatchedLayout(t *testing.T) {
+	encCfg := params.MakeEncodingConfig()
+	ics.RegisterInterfaces(encCfg.InterfaceRegistry)
+
+	cdc := codec.NewProtoCodec(encCfg.InterfaceRegistry)
+
+	// consumer_id holds an invalid UTF-8 string.
+	var msg sdk.Msg
+	err := cdc.UnpackAny(&codectypes.Any{
+		TypeUrl: "/interchain_security.ccv.provider.v1.MsgRemoveConsumer",
+		Value:   appendLenField(nil, 1, []byte{0xff}),
+	}, &msg)
+	require.NoError(t, err)
+
+	bz, err := cdc.MarshalInterfaceJSON(msg)
+	require.NoError(t, err)
+	require.Contains(t, string(bz), `"consumer_id":"/w=="`)
+}
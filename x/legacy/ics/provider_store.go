@@ -0,0 +1,334 @@
+package ics
+
+import (
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/encoding/protojson"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/reflect/protoregistry"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// ProviderStoreKey is the store key of the removed ICS provider module. Its
+// contents are deleted by the v29 upgrade.
+const ProviderStoreKey = ModuleName
+
+// ProviderStoreEntry is a key/value pair of the provider store, decoded with
+// the layout of the ICS v6 and v7 provider keeper.
+type ProviderStoreEntry struct {
+	// Prefix is the name of the key prefix, or its hex encoding, e.g. "0x2a",
+	// if the prefix is unknown.
+	Prefix string `json:"prefix"`
+	// Key holds the decoded parts of the key following the prefix, e.g.
+	// consumer_id and provider_addr.
+	Key map[string]string `json:"key,omitempty"`
+	// RawKey is the hex encoded key following the prefix, set if it could not
+	// be decoded.
+	RawKey string `json:"raw_key,omitempty"`
+	// Value is the decoded value, or the base64 encoded value if it could not
+	// be decoded.
+	Value json.RawMessage `json:"value"`
+}
+
+// IterateProviderStore decodes every entry of the provider store, in key
+// order, and calls cb on it. Iteration stops at the first error of cb.
+func IterateProviderStore(store storetypes.KVStore, cb func(entry ProviderStoreEntry) error) error {
+	it := store.Iterator(nil, nil)
+	defer it.Close()
+
+	for ; it.Valid(); it.Next() {
+		if err := cb(DecodeProviderStoreEntry(it.Key(), it.Value())); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DecodeProviderStoreEntry decodes a key/value pair of the provider store.
+// Decoding never fails: keys of unknown prefixes, or which do not match the
+// layout of their prefix, are returned hex encoded, and values which cannot be
+// decoded are returned base64 encoded.
+func DecodeProviderStoreEntry(key, value []byte) ProviderStoreEntry {
+	if len(key) == 0 {
+		return ProviderStoreEntry{Prefix: "0x", Value: rawValue(value)}
+	}
+
+	layout, ok := providerKeys[key[0]]
+	if !ok {
+		return ProviderStoreEntry{
+			Prefix: fmt.Sprintf("0x%02x", key[0]),
+			RawKey: hex.EncodeToString(key[1:]),
+			Value:  rawValue(value),
+		}
+	}
+
+	entry := ProviderStoreEntry{Prefix: layout.name}
+	if parts, err := decodeKey(layout.parts, key[1:]); err == nil {
+		entry.Key = parts
+	} else {
+		entry.RawKey = hex.EncodeToString(key[1:])
+	}
+
+	decoded, err := layout.value(value)
+	if err != nil {
+		entry.Value = rawValue(value)
+		return entry
+	}
+	bz, err := json.Marshal(decoded)
+	if err != nil {
+		entry.Value = rawValue(value)
+		return entry
+	}
+	entry.Value = bz
+	return entry
+}
+
+// providerKey is the layout of the keys of a provider store prefix and of
+// their values.
+type providerKey struct {
+	name  string
+	parts []keyPart
+	value valueDecoder
+}
+
+// keyPart decodes a part of a key, returning its value and the remaining
+// bytes.
+type keyPart struct {
+	name   string
+	decode func(bz []byte) (string, []byte, error)
+}
+
+// valueDecoder decodes a value into a JSON encodable value.
+type valueDecoder func(bz []byte) (any, error)
+
+// providerKeys are the layouts of the provider store prefixes holding consumer
+// chain records, key assignments and validator sets. The other prefixes, e.g.
+// of the deprecated packet and slash throttling state, are exported raw.
+var providerKeys = map[byte]providerKey{
+	0x00: {"Port", nil, stringValue},
+	0x01: {"ValidatorSetUpdateId", nil, uint64Value},
+	0x02: {"SlashMeter", nil, intValue},
+	0x03: {"SlashMeterReplenishTimeCandidate", nil, timeValue},
+	0x04: {"ConsumerIdToChannelId", []keyPart{consumerID}, stringValue},
+	0x05: {"ChannelIdToConsumerId", []keyPart{restString("channel_id")}, stringValue},
+	0x06: {"ConsumerIdToClientId", []keyPart{consumerID}, stringValue},
+	0x0f: {"InitChainHeight", []keyPart{consumerID}, uint64Value},
+	0x15: {"ConsumerValidators", []keyPart{consumerID, providerAddr}, protoValue("PublicKey")},
+	0x16: {"ValidatorsByConsumerAddr", []keyPart{consumerID, consumerAddr}, consAddrValue},
+	0x1a: {"ConsumerRewardDenoms", []keyPart{restString("denom")}, emptyValue},
+	0x1c: {"EquivocationEvidenceMinHeight", []keyPart{consumerID}, uint64Value},
+	0x1e: {"ConsumerValidator", []keyPart{consumerID, providerAddr}, protoValue("ConsensusValidator")},
+	0x1f: {"OptedIn", []keyPart{consumerID, providerAddr}, emptyValue},
+	0x23: {"Allowlist", []keyPart{consumerID, providerAddr}, emptyValue},
+	0x24: {"Denylist", []keyPart{consumerID, providerAddr}, emptyValue},
+	0x26: {"ConsumerCommissionRate", []keyPart{consumerID, providerAddr}, decValue},
+	0x27: {"MinimumPowerInTopN", []keyPart{consumerID}, uint64Value},
+	0x28: {"ConsumerAddrsToPrune", []keyPart{consumerID, timePart("prune_time")}, protoValue("AddressList")},
+	0x29: {"LastProviderConsensusValidator", []keyPart{providerAddr}, protoValue("ConsensusValidator")},
+	0x2b: {"ConsumerId", nil, uint64Value},
+	0x2c: {"ConsumerIdToChainId", []keyPart{consumerID}, stringValue},
+	0x2d: {"ConsumerIdToOwnerAddress", []keyPart{consumerID}, stringValue},
+	0x2e: {"ConsumerIdToMetadata", []keyPart{consumerID}, protoValue("ConsumerMetadata")},
+	0x2f: {"ConsumerIdToInitializationParameters", []keyPart{consumerID}, protoValue("ConsumerInitializationParameters")},
+	0x30: {"ConsumerIdToPowerShapingParameters", []keyPart{consumerID}, protoValue("PowerShapingParameters")},
+	0x31: {"ConsumerIdToPhase", []keyPart{consumerID}, phaseValue},
+	0x32: {"ConsumerIdToRemovalTime", []keyPart{consumerID}, timeValue},
+	0x33: {"SpawnTimeToConsumerIds", []keyPart{timePart("spawn_time")}, protoValue("ConsumerIds")},
+	0x34: {"RemovalTimeToConsumerIds", []keyPart{timePart("removal_time")}, protoValue("ConsumerIds")},
+	0x35: {"ClientIdToConsumerId", []keyPart{restString("client_id")}, stringValue},
+	0x36: {"Prioritylist", []keyPart{consumerID, providerAddr}, emptyValue},
+	0x37: {"ConsumerIdToInfractionParameters", []keyPart{consumerID}, protoValue("InfractionParameters")},
+	0xff: {"Parameters", nil, bytesValue},
+}
+
+// consumerPhases are the names of the ConsumerPhase enum values.
+var consumerPhases = []string{
+	"CONSUMER_PHASE_UNSPECIFIED",
+	"CONSUMER_PHASE_REGISTERED",
+	"CONSUMER_PHASE_INITIALIZED",
+	"CONSUMER_PHASE_LAUNCHED",
+	"CONSUMER_PHASE_STOPPED",
+	"CONSUMER_PHASE_DELETED",
+}
+
+var errInvalidKey = errors.New("invalid key")
+
+func decodeKey(parts []keyPart, bz []byte) (map[string]string, error) {
+	if len(parts) == 0 {
+		if len(bz) != 0 {
+			return nil, errInvalidKey
+		}
+		return nil, nil
+	}
+
+	decoded := make(map[string]string, len(parts))
+	for _, part := range parts {
+		value, rest, err := part.decode(bz)
+		if err != nil {
+			return nil, err
+		}
+		decoded[part.name] = value
+		bz = rest
+	}
+	if len(bz) != 0 {
+		return nil, errInvalidKey
+	}
+	return decoded, nil
+}
+
+// consumerID is a consumer id prefixed by its big endian uint64 length.
+var consumerID = keyPart{"consumer_id", func(bz []byte) (string, []byte, error) {
+	if len(bz) < 8 {
+		return "", nil, errInvalidKey
+	}
+	n := binary.BigEndian.Uint64(bz)
+	if n > uint64(len(bz)-8) {
+		return "", nil, errInvalidKey
+	}
+	return string(bz[8 : 8+n]), bz[8+n:], nil
+}}
+
+// providerAddr is the remaining key bytes, a provider consensus address.
+var providerAddr = keyPart{"provider_addr", func(bz []byte) (string, []byte, error) {
+	if len(bz) == 0 {
+		return "", nil, errInvalidKey
+	}
+	return sdk.ConsAddress(bz).String(), nil, nil
+}}
+
+// consumerAddr is the remaining key bytes, a consumer consensus address,
+// rendered with the bech32 prefix of the provider like the ICS queries did.
+var consumerAddr = keyPart{"consumer_addr", providerAddr.decode}
+
+// restString is the remaining key bytes, as a string.
+func restString(name string) keyPart {
+	return keyPart{name, func(bz []byte) (string, []byte, error) {
+		if len(bz) == 0 {
+			return "", nil, errInvalidKey
+		}
+		return string(bz), nil, nil
+	}}
+}
+
+// timePart is a time encoded with sdk.FormatTimeBytes.
+func timePart(name string) keyPart {
+	size := len(sdk.FormatTimeBytes(time.Time{}))
+	return keyPart{name, func(bz []byte) (string, []byte, error) {
+		if len(bz) < size {
+			return "", nil, errInvalidKey
+		}
+		t, err := sdk.ParseTimeBytes(bz[:size])
+		if err != nil {
+			return "", nil, err
+		}
+		return t.Format(time.RFC3339Nano), bz[size:], nil
+	}}
+}
+
+func emptyValue(bz []byte) (any, error) {
+	if len(bz) != 0 {
+		return nil, errors.New("value is not empty")
+	}
+	return nil, nil
+}
+
+func bytesValue(bz []byte) (any, error) {
+	return bz, nil
+}
+
+func stringValue(bz []byte) (any, error) {
+	return string(bz), nil
+}
+
+func uint64Value(bz []byte) (any, error) {
+	if len(bz) != 8 {
+		return nil, fmt.Errorf("invalid uint64 length %d", len(bz))
+	}
+	return binary.BigEndian.Uint64(bz), nil
+}
+
+func intValue(bz []byte) (any, error) {
+	var i math.Int
+	if err := i.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return i, nil
+}
+
+func decValue(bz []byte) (any, error) {
+	var d math.LegacyDec
+	if err := d.Unmarshal(bz); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+func consAddrValue(bz []byte) (any, error) {
+	if len(bz) == 0 {
+		return nil, errors.New("empty address")
+	}
+	return sdk.ConsAddress(bz).String(), nil
+}
+
+func phaseValue(bz []byte) (any, error) {
+	if len(bz) != 1 || int(bz[0]) >= len(consumerPhases) {
+		return nil, fmt.Errorf("invalid consumer phase %x", bz)
+	}
+	return consumerPhases[bz[0]], nil
+}
+
+// timeValue decodes a time encoded with sdk.FormatTimeBytes or
+// time.Time.MarshalBinary.
+func timeValue(bz []byte) (any, error) {
+	if t, err := sdk.ParseTimeBytes(bz); err == nil {
+		return t.UTC(), nil
+	}
+	var t time.Time
+	if err := t.UnmarshalBinary(bz); err != nil {
+		return nil, err
+	}
+	return t.UTC(), nil
+}
+
+// protoValue decodes a value with the archived layout of the provider message
+// name.
+func protoValue(name protoreflect.Name) valueDecoder {
+	return func(bz []byte) (any, error) {
+		desc, err := protoregistry.GlobalFiles.FindDescriptorByName(protoreflect.FullName(legacyPkg).Append(name))
+		if err != nil {
+			return nil, err
+		}
+		msgDesc, ok := desc.(protoreflect.MessageDescriptor)
+		if !ok {
+			return nil, fmt.Errorf("%s is not a message", desc.FullName())
+		}
+		msg := dynamicpb.NewMessage(msgDesc)
+		if err := protov2.Unmarshal(bz, msg); err != nil {
+			return nil, err
+		}
+		out, err := protojson.MarshalOptions{UseProtoNames: true}.Marshal(msg)
+		if err != nil {
+			return nil, err
+		}
+		return json.RawMessage(out), nil
+	}
+}
+
+func rawValue(bz []byte) json.RawMessage {
+	out, err := json.Marshal(bz)
+	if err != nil {
+		return json.RawMessage("null")
+	}
+	return out
+}
@@ -0,0 +1,119 @@
+package ics_test
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/math"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/testutil"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/legacy/ics"
+)
+
+// consumerKey returns a provider store key of prefix for consumerID, followed
+// by suffix.
+func consumerKey(prefix byte, consumerID string, suffix ...byte) []byte {
+	key := binary.BigEndian.AppendUint64([]byte{prefix}, uint64(len(consumerID)))
+	key = append(key, consumerID...)
+	return append(key, suffix...)
+}
+
+func TestDecodeProviderStoreEntry(t *testing.T) {
+	providerAddr := sdk.ConsAddress(make([]byte, 20))
+	spawnTime := time.Date(2024, 9, 1, 12, 0, 0, 0, time.UTC)
+	rate, err := math.LegacyNewDecWithPrec(5, 2).Marshal()
+	require.NoError(t, err)
+
+	testCases := []struct {
+		name       string
+		key, value []byte
+		expected   ics.ProviderStoreEntry
+	}{
+		{
+			"chain id",
+			consumerKey(0x2c, "0"), []byte("neutron-1"),
+			ics.ProviderStoreEntry{Prefix: "ConsumerIdToChainId", Key: map[string]string{"consumer_id": "0"}, Value: json.RawMessage(`"neutron-1"`)},
+		},
+		{
+			"phase",
+			consumerKey(0x31, "12"), []byte{3},
+			ics.ProviderStoreEntry{Prefix: "ConsumerIdToPhase", Key: map[string]string{"consumer_id": "12"}, Value: json.RawMessage(`"CONSUMER_PHASE_LAUNCHED"`)},
+		},
+		{
+			"opted in",
+			consumerKey(0x1f, "0", providerAddr...), nil,
+			ics.ProviderStoreEntry{
+				Prefix: "OptedIn",
+				Key:    map[string]string{"consumer_id": "0", "provider_addr": providerAddr.String()},
+				Value:  json.RawMessage(`null`),
+			},
+		},
+		{
+			"commission rate",
+			consumerKey(0x26, "0", providerAddr...), rate,
+			ics.ProviderStoreEntry{
+				Prefix: "ConsumerCommissionRate",
+				Key:    map[string]string{"consumer_id": "0", "provider_addr": providerAddr.String()},
+				Value:  json.RawMessage(`"0.050000000000000000"`),
+			},
+		},
+		{
+			"metadata",
+			// name = "n"
+			consumerKey(0x2e, "0"), []byte{0x0a, 0x01, 'n'},
+			ics.ProviderStoreEntry{Prefix: "ConsumerIdToMetadata", Key: map[string]string{"consumer_id": "0"}, Value: json.RawMessage(`{"name":"n"}`)},
+		},
+		{
+			"spawn time",
+			// ids = ["0", "1"]
+			append([]byte{0x33}, sdk.FormatTimeBytes(spawnTime)...), []byte{0x0a, 0x01, '0', 0x0a, 0x01, '1'},
+			ics.ProviderStoreEntry{
+				Prefix: "SpawnTimeToConsumerIds",
+				Key:    map[string]string{"spawn_time": "2024-09-01T12:00:00Z"},
+				Value:  json.RawMessage(`{"ids":["0","1"]}`),
+			},
+		},
+		{
+			"invalid key",
+			[]byte{0x2c, 0xff}, []byte("neutron-1"),
+			ics.ProviderStoreEntry{Prefix: "ConsumerIdToChainId", RawKey: "ff", Value: json.RawMessage(`"neutron-1"`)},
+		},
+		{
+			"invalid value",
+			consumerKey(0x31, "0"), []byte{42},
+			ics.ProviderStoreEntry{Prefix: "ConsumerIdToPhase", Key: map[string]string{"consumer_id": "0"}, Value: json.RawMessage(`"Kg=="`)},
+		},
+		{
+			"unknown prefix",
+			[]byte{0x13, 0x01}, []byte{0x02},
+			ics.ProviderStoreEntry{Prefix: "0x13", RawKey: "01", Value: json.RawMessage(`"Ag=="`)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, tc.expected, ics.DecodeProviderStoreEntry(tc.key, tc.value))
+		})
+	}
+}
+
+func TestIterateProviderStore(t *testing.T) {
+	key := storetypes.NewKVStoreKey(ics.ProviderStoreKey)
+	store := testutil.DefaultContext(key, storetypes.NewTransientStoreKey("transient")).KVStore(key)
+	store.Set(consumerKey(0x2d, "0"), []byte("cosmos1owner"))
+	store.Set(consumerKey(0x2c, "0"), []byte("neutron-1"))
+
+	var prefixes []string
+	require.NoError(t, ics.IterateProviderStore(store, func(entry ics.ProviderStoreEntry) error {
+		prefixes = append(prefixes, entry.Prefix)
+		return nil
+	}))
+	require.Equal(t, []string{"ConsumerIdToChainId", "ConsumerIdToOwnerAddress"}, prefixes)
+}
@@ -0,0 +1,207 @@
+package legacy
+
+import (
+	"bytes"
+	"encoding/json"
+	"reflect"
+
+	"github.com/cosmos/gogoproto/jsonpb"
+	"google.golang.org/protobuf/encoding/protojson"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	errorsmod "cosmossdk.io/errors"
+
+	gaiaerrors "github.com/cosmos/gaia/v29/types/errors"
+)
+
+// Type is implemented by the types instantiating Message. The interface
+// registry resolves type URLs to Go types, so each archived message is decoded
+// by a distinct instantiation of Message, and T tells them apart, e.g. an
+// empty struct per archived message. Archive returns the archive the
+// instantiation is bound to by NewArchive, and is not called before
+// NewArchive returns.
+type Type interface {
+	Archive() *Archive
+}
+
+// Message decodes an archived message.
+//
+// Message keeps the raw protobuf bytes of the message as is, and decodes them
+// with the archived layout when rendered as JSON. It implements both sdk.Msg
+// and govv1beta1.Content.
+type Message[T Type] struct {
+	raw []byte
+}
+
+// archivedMessage is implemented by the instantiations of Message.
+type archivedMessage interface {
+	archived() *archivedType
+}
+
+// archived returns the archived type decoded by m, or nil if the Go type of m
+// is not bound to an archived type.
+func (m *Message[T]) archived() *archivedType {
+	var t T
+	return t.Archive().lookupType(reflect.TypeOf(m))
+}
+
+func (m *Message[T]) ProtoMessage()                      {}
+func (m *Message[T]) Reset()                             { m.raw = nil }
+func (m *Message[T]) Marshal() ([]byte, error)           { return bytes.Clone(m.raw), nil }
+func (m *Message[T]) MarshalTo(dAtA []byte) (int, error) { return copy(dAtA, m.raw), nil }
+func (m *Message[T]) Size() int                          { return len(m.raw) }
+
+func (m *Message[T]) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	return copy(dAtA[len(dAtA)-len(m.raw):], m.raw), nil
+}
+
+func (m *Message[T]) Unmarshal(dAtA []byte) error {
+	m.raw = bytes.Clone(dAtA)
+	return nil
+}
+
+func (m *Message[T]) String() string {
+	bz, err := m.MarshalJSONPB(&jsonpb.Marshaler{OrigName: true})
+	if err != nil {
+		return "{}"
+	}
+	return string(bz)
+}
+
+// Descriptor satisfies the descriptorIface required by the Cosmos SDK's
+// unknownproto package for field validation. It returns the lenient layout of
+// the archived message, see wireDescriptor, so that historical data is never
+// rejected by the tx decoder.
+func (m *Message[T]) Descriptor() ([]byte, []int) {
+	t := m.archived()
+	if t == nil {
+		return nil, nil
+	}
+	return t.wireFile, t.index
+}
+
+// ValidateBasic rejects new transactions and proposals containing archived
+// types. Historical decoding paths never call ValidateBasic, so existing
+// state queries are unaffected.
+func (m *Message[T]) ValidateBasic() error {
+	name := "unknown"
+	if t := m.archived(); t != nil {
+		name = string(t.desc.FullName())
+	}
+	return errorsmod.Wrapf(gaiaerrors.ErrUnauthorized, "legacy type %s of a removed module: cannot submit new transaction or proposal", name)
+}
+
+// MarshalJSONPB implements jsonpb.JSONPBMarshaler with the archived layout.
+// Data which does not match the archived layout, e.g. written by a release of
+// the removed module with a different layout, is encoded with the lenient
+// layout instead, so that historical data never fails to render.
+func (m *Message[T]) MarshalJSONPB(jm *jsonpb.Marshaler) ([]byte, error) {
+	t := m.archived()
+	if t == nil {
+		return []byte("{}"), nil
+	}
+
+	opts := protojson.MarshalOptions{}
+	if jm != nil {
+		opts.UseProtoNames = jm.OrigName
+		opts.EmitUnpopulated = jm.EmitDefaults
+	}
+	msg, err := m.decode(t.desc)
+	if err != nil {
+		if msg, err = m.decode(t.wireDesc); err != nil {
+			return nil, err
+		}
+	}
+	bz, err := opts.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+	// protojson output randomly varies in whitespace, compact it so that the
+	// output is deterministic.
+	var buf bytes.Buffer
+	if err := json.Compact(&buf, bz); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSONPB implements jsonpb.JSONPBUnmarshaler with the archived
+// layout. This is the hook that gogoproto's jsonpb Unmarshaler calls before
+// its reflection-based field parser, which knows no field of Message. Unknown
+// fields are discarded, and JSON which does not match the archived layout
+// leaves the message empty, so that decoding historical JSON transactions
+// never fails.
+func (m *Message[T]) UnmarshalJSONPB(_ *jsonpb.Unmarshaler, bz []byte) error {
+	m.raw = nil
+	t := m.archived()
+	if t == nil {
+		return nil
+	}
+
+	msg := dynamicpb.NewMessage(t.desc)
+	if err := (protojson.UnmarshalOptions{DiscardUnknown: true}).Unmarshal(bz, msg); err != nil {
+		return nil
+	}
+	raw, err := protov2.Marshal(msg)
+	if err != nil {
+		return nil
+	}
+	m.raw = raw
+	return nil
+}
+
+// GetTitle returns the title field of an archived proposal content.
+func (m *Message[T]) GetTitle() string {
+	return m.stringField("title")
+}
+
+// GetDescription returns the description field of an archived proposal
+// content.
+func (m *Message[T]) GetDescription() string {
+	return m.stringField("description")
+}
+
+// ProposalRoute returns the name of the removed module.
+func (m *Message[T]) ProposalRoute() string {
+	if t := m.archived(); t != nil {
+		return t.module
+	}
+	return ""
+}
+
+// ProposalType returns the name of the archived proposal content without its
+// Proposal suffix.
+func (m *Message[T]) ProposalType() string {
+	if t := m.archived(); t != nil {
+		return proposalType(t.desc)
+	}
+	return ""
+}
+
+func (m *Message[T]) decode(desc protoreflect.MessageDescriptor) (*dynamicpb.Message, error) {
+	msg := dynamicpb.NewMessage(desc)
+	if err := protov2.Unmarshal(m.raw, msg); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// stringField returns the value of the string field name, or the empty string
+// if it is not a field of the archived message or cannot be decoded.
+func (m *Message[T]) stringField(name protoreflect.Name) string {
+	t := m.archived()
+	if t == nil {
+		return ""
+	}
+	field := t.desc.Fields().ByName(name)
+	if field == nil || field.Kind() != protoreflect.StringKind || field.IsList() {
+		return ""
+	}
+	msg, err := m.decode(t.desc)
+	if err != nil {
+		return ""
+	}
+	return msg.Get(field).String()
+}
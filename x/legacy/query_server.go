@@ -0,0 +1,27 @@
+package legacy
+
+import (
+	"context"
+
+	"github.com/cosmos/gaia/v29/x/legacy/types"
+)
+
+var _ types.QueryServer = queryServer{}
+
+type queryServer struct {
+	archives []*Archive
+}
+
+// NewQueryServer returns an implementation of the legacy QueryServer
+// listing the types of archives.
+func NewQueryServer(archives ...*Archive) types.QueryServer {
+	return queryServer{archives: archives}
+}
+
+func (q queryServer) Types(_ context.Context, _ *types.QueryTypesRequest) (*types.QueryTypesResponse, error) {
+	legacyTypes := []types.LegacyType{}
+	for _, archive := range q.archives {
+		legacyTypes = append(legacyTypes, archive.Types()...)
+	}
+	return &types.QueryTypesResponse{Types: legacyTypes}, nil
+}
@@ -0,0 +1,13 @@
+package types
+
+const (
+	// ModuleName is the name of the legacy module
+	ModuleName = "legacy"
+
+	// MsgInterface is the name of the interface of archived messages
+	MsgInterface = "cosmos.base.v1beta1.Msg"
+
+	// ContentInterface is the name of the interface of archived governance
+	// proposals
+	ContentInterface = "cosmos.gov.v1beta1.Content"
+)
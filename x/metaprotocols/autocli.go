@@ -0,0 +1,70 @@
+package metaprotocols
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+func (a AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service:              types.Query_serviceDesc.ServiceName,
+			EnhanceCustomCommand: true,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the metaprotocols registry parameters",
+					Example:   fmt.Sprintf("$ %s query metaprotocols params", version.AppName),
+				},
+				{
+					RpcMethod: "Protocol",
+					Use:       "protocol [protocol-id]",
+					Short:     "Query a registered metaprotocol",
+					Example:   fmt.Sprintf("$ %s query metaprotocols protocol some-protocol", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "protocol_id"},
+					},
+				},
+				{
+					RpcMethod: "Protocols",
+					Use:       "protocols",
+					Short:     "Query all registered metaprotocols",
+					Example:   fmt.Sprintf("$ %s query metaprotocols protocols", version.AppName),
+				},
+				{
+					RpcMethod: "Extensions",
+					Use:       "extensions [protocol-id]",
+					Short:     "Query the indexed extensions of a protocol, optionally filtered by --signer",
+					Long:      "Query the ExtensionData recorded by the node-local metaprotocols indexer. The queried node must enable the indexer in app.toml.",
+					Example:   fmt.Sprintf("$ %s query metaprotocols extensions some-protocol --signer cosmos1...", version.AppName),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "protocol_id"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RegisterProtocol",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "DeregisterProtocol",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,122 @@
+package cli
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"slices"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+const (
+	FlagMetaprotocolID       = "metaprotocol-id"
+	FlagMetaprotocolVersion  = "metaprotocol-version"
+	FlagMetaprotocolData     = "metaprotocol-data"
+	FlagMetaprotocolDataFile = "metaprotocol-data-file"
+	FlagMetaprotocolCritical = "metaprotocol-critical"
+)
+
+// AddExtensionFlags adds the flags attaching an ExtensionData to the
+// transactions generated by cmd and its subcommands.
+func AddExtensionFlags(cmd *cobra.Command) {
+	cmd.PersistentFlags().String(FlagMetaprotocolID, "", "Attach ExtensionData with this protocol ID as a non-critical extension option of the tx")
+	cmd.PersistentFlags().String(FlagMetaprotocolVersion, "", "Protocol version of the attached ExtensionData")
+	cmd.PersistentFlags().String(FlagMetaprotocolData, "", "Data of the attached ExtensionData")
+	cmd.PersistentFlags().String(FlagMetaprotocolDataFile, "", "File holding the data of the attached ExtensionData")
+	cmd.PersistentFlags().Bool(FlagMetaprotocolCritical, false, "Attach the ExtensionData as a critical extension option, which governance must allow for its protocol")
+}
+
+// ReadExtensionFlags returns clientCtx with a TxConfig attaching the
+// ExtensionData described by the metaprotocol flags to every tx it builds.
+// clientCtx is returned unchanged if the flags are not set.
+func ReadExtensionFlags(clientCtx client.Context, flagSet *pflag.FlagSet) (client.Context, error) {
+	if flagSet.Lookup(FlagMetaprotocolID) == nil {
+		return clientCtx, nil
+	}
+
+	protocolID, _ := flagSet.GetString(FlagMetaprotocolID)
+	protocolVersion, _ := flagSet.GetString(FlagMetaprotocolVersion)
+	data, _ := flagSet.GetString(FlagMetaprotocolData)
+	dataFile, _ := flagSet.GetString(FlagMetaprotocolDataFile)
+	critical, _ := flagSet.GetBool(FlagMetaprotocolCritical)
+
+	if protocolID == "" {
+		if protocolVersion != "" || data != "" || dataFile != "" || critical {
+			return clientCtx, fmt.Errorf("--%s is required to attach ExtensionData", FlagMetaprotocolID)
+		}
+		return clientCtx, nil
+	}
+	if data != "" && dataFile != "" {
+		return clientCtx, fmt.Errorf("--%s and --%s are mutually exclusive", FlagMetaprotocolData, FlagMetaprotocolDataFile)
+	}
+
+	ext := &types.ExtensionData{
+		ProtocolId:      protocolID,
+		ProtocolVersion: protocolVersion,
+		Data:            []byte(data),
+	}
+	if dataFile != "" {
+		bz, err := os.ReadFile(dataFile)
+		if err != nil {
+			return clientCtx, err
+		}
+		ext.Data = bz
+	}
+	if clientCtx.TxConfig == nil {
+		return clientCtx, errors.New("tx config is not set")
+	}
+
+	option, err := codectypes.NewAnyWithValue(ext)
+	if err != nil {
+		return clientCtx, err
+	}
+	return clientCtx.WithTxConfig(extensionTxConfig{
+		TxConfig:  clientCtx.TxConfig,
+		extension: option,
+		critical:  critical,
+	}), nil
+}
+
+// extensionTxConfig attaches its extension to every new tx builder.
+type extensionTxConfig struct {
+	client.TxConfig
+
+	extension *codectypes.Any
+	critical  bool
+}
+
+func (c extensionTxConfig) NewTxBuilder() client.TxBuilder {
+	builder := c.TxConfig.NewTxBuilder()
+	extBuilder, ok := builder.(authtx.ExtensionOptionsTxBuilder)
+	if !ok {
+		return builder
+	}
+	if c.critical {
+		// the tx factory overwrites the critical extension options
+		// once it has set the messages
+		extBuilder.SetExtensionOptions(c.extension)
+		return criticalExtensionTxBuilder{ExtensionOptionsTxBuilder: extBuilder, extension: c.extension}
+	}
+	extBuilder.SetNonCriticalExtensionOptions(c.extension)
+	return builder
+}
+
+// criticalExtensionTxBuilder keeps its extension among the critical
+// extension options set on the tx.
+type criticalExtensionTxBuilder struct {
+	authtx.ExtensionOptionsTxBuilder
+
+	extension *codectypes.Any
+}
+
+func (b criticalExtensionTxBuilder) SetExtensionOptions(extOpts ...*codectypes.Any) {
+	b.ExtensionOptionsTxBuilder.SetExtensionOptions(slices.Concat(extOpts, []*codectypes.Any{b.extension})...)
+}
@@ -0,0 +1,102 @@
+package cli_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/spf13/cobra"
+	"github.com/stretchr/testify/require"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	clienttx "github.com/cosmos/cosmos-sdk/client/tx"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/client/cli"
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+func TestReadExtensionFlags(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	types.RegisterInterfaces(registry)
+	banktypes.RegisterInterfaces(registry)
+	clientCtx := client.Context{}.WithTxConfig(authtx.NewTxConfig(codec.NewProtoCodec(registry), authtx.DefaultSignModes))
+
+	dataFile := filepath.Join(t.TempDir(), "data.json")
+	require.NoError(t, os.WriteFile(dataFile, []byte(`{"text": "gm"}`), 0o600))
+
+	testCases := []struct {
+		name        string
+		args        []string
+		expExt      *types.ExtensionData
+		expCritical bool
+		expErr      string
+	}{
+		{
+			name: "no flags",
+		},
+		{
+			name:   "data",
+			args:   []string{"--metaprotocol-id=posts", "--metaprotocol-version=1", "--metaprotocol-data=gm"},
+			expExt: &types.ExtensionData{ProtocolId: "posts", ProtocolVersion: "1", Data: []byte("gm")},
+		},
+		{
+			name:   "data file",
+			args:   []string{"--metaprotocol-id=posts", "--metaprotocol-data-file=" + dataFile},
+			expExt: &types.ExtensionData{ProtocolId: "posts", Data: []byte(`{"text": "gm"}`)},
+		},
+		{
+			name:        "critical",
+			args:        []string{"--metaprotocol-id=posts", "--metaprotocol-data=gm", "--metaprotocol-critical"},
+			expExt:      &types.ExtensionData{ProtocolId: "posts", Data: []byte("gm")},
+			expCritical: true,
+		},
+		{
+			name:   "missing protocol id",
+			args:   []string{"--metaprotocol-data=gm"},
+			expErr: "--metaprotocol-id is required",
+		},
+		{
+			name:   "data and data file",
+			args:   []string{"--metaprotocol-id=posts", "--metaprotocol-data=gm", "--metaprotocol-data-file=" + dataFile},
+			expErr: "mutually exclusive",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cmd := &cobra.Command{}
+			cli.AddExtensionFlags(cmd)
+			require.NoError(t, cmd.ParseFlags(tc.args))
+
+			ctx, err := cli.ReadExtensionFlags(clientCtx, cmd.Flags())
+			if tc.expErr != "" {
+				require.ErrorContains(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			txf := clienttx.Factory{}.WithTxConfig(ctx.TxConfig).WithChainID("test")
+			builder, err := txf.BuildUnsignedTx(&banktypes.MsgSend{})
+			require.NoError(t, err)
+
+			extTx, ok := builder.GetTx().(authante.HasExtensionOptionsTx)
+			require.True(t, ok)
+			options, otherOptions := extTx.GetNonCriticalExtensionOptions(), extTx.GetExtensionOptions()
+			if tc.expCritical {
+				options, otherOptions = otherOptions, options
+			}
+			require.Empty(t, otherOptions)
+			if tc.expExt == nil {
+				require.Empty(t, options)
+				return
+			}
+			require.Len(t, options, 1)
+			require.Equal(t, tc.expExt, options[0].GetCachedValue())
+		})
+	}
+}
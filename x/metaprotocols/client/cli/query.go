@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	txtypes "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/version"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+// GetQueryCmd returns the root CLI command handler for the x/metaprotocols
+// query commands that are not generated by autocli.
+func GetQueryCmd() *cobra.Command {
+	queryCmd := &cobra.Command{
+		Use:                        types.ModuleName,
+		Short:                      "Querying commands for the metaprotocols module",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	queryCmd.AddCommand(
+		GetCmdDecodeTx(),
+	)
+
+	return queryCmd
+}
+
+// decodedExtension is the printed form of an ExtensionData. Data is printed
+// as JSON when it is JSON or decodes as the message type of its registered
+// protocol, and base64 encoded otherwise.
+type decodedExtension struct {
+	Critical        bool            `json:"critical"`
+	ProtocolID      string          `json:"protocol_id"`
+	ProtocolVersion string          `json:"protocol_version"`
+	Data            json.RawMessage `json:"data"`
+}
+
+// GetCmdDecodeTx defines a command printing the ExtensionData attached to a
+// committed tx.
+func GetCmdDecodeTx() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decode-tx [hash]",
+		Short: "Print the ExtensionData attached to a committed tx",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`Print the ExtensionData attached to a committed tx.
+
+The data of an extension is printed as JSON if it is JSON, or if its protocol
+is registered with a protobuf message type, and base64 encoded otherwise.
+
+Example:
+$ %s query metaprotocols decode-tx <hash>
+`,
+				version.AppName,
+			),
+		),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txResp, err := authtx.QueryTx(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+			protoTx, ok := txResp.Tx.GetCachedValue().(*txtypes.Tx)
+			if !ok || protoTx.Body == nil {
+				return fmt.Errorf("tx %s cannot be decoded", args[0])
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			extensions := []decodedExtension{}
+			for _, options := range []struct {
+				critical bool
+				anys     []*codectypes.Any
+			}{
+				{true, protoTx.Body.ExtensionOptions},
+				{false, protoTx.Body.NonCriticalExtensionOptions},
+			} {
+				for _, option := range options.anys {
+					ext, ok := option.GetCachedValue().(*types.ExtensionData)
+					if !ok {
+						continue
+					}
+					decoded, err := decodeExtension(cmd, queryClient, ext)
+					if err != nil {
+						return err
+					}
+					decoded.Critical = options.critical
+					extensions = append(extensions, decoded)
+				}
+			}
+
+			out, err := json.Marshal(struct {
+				TxHash     string             `json:"txhash"`
+				Height     int64              `json:"height"`
+				Extensions []decodedExtension `json:"extensions"`
+			}{txResp.TxHash, txResp.Height, extensions})
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintRaw(out)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// decodeExtension decodes the data of ext with its registered protocol, if
+// any.
+func decodeExtension(cmd *cobra.Command, queryClient types.QueryClient, ext *types.ExtensionData) (decodedExtension, error) {
+	decoded := decodedExtension{
+		ProtocolID:      ext.ProtocolId,
+		ProtocolVersion: ext.ProtocolVersion,
+	}
+
+	var protocol types.RegisteredProtocol
+	res, err := queryClient.Protocol(cmd.Context(), &types.QueryProtocolRequest{ProtocolId: ext.ProtocolId})
+	switch {
+	case err == nil:
+		protocol = res.Protocol
+	case status.Code(err) != codes.NotFound:
+		return decoded, err
+	}
+
+	if data, err := protocol.DataToJSON(ext.Data); err == nil {
+		decoded.Data = data
+		return decoded, nil
+	}
+	decoded.Data, err = json.Marshal(ext.Data)
+	return decoded, err
+}
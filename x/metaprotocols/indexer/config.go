@@ -0,0 +1,53 @@
+package indexer
+
+import (
+	"fmt"
+
+	"github.com/spf13/cast"
+
+	servertypes "github.com/cosmos/cosmos-sdk/server/types"
+)
+
+const flagIndexEnabled = "metaprotocols.index_enabled"
+
+// Config is the node configuration of the metaprotocols indexer, read from
+// the [metaprotocols] section of app.toml.
+type Config struct {
+	// IndexEnabled turns on the node-local index of ExtensionData.
+	IndexEnabled bool `mapstructure:"index_enabled"`
+}
+
+// DefaultConfig returns the default indexer configuration, with indexing disabled.
+func DefaultConfig() Config {
+	return Config{
+		IndexEnabled: false,
+	}
+}
+
+// DefaultConfigTemplate returns the app.toml snippet of the default configuration.
+func DefaultConfigTemplate() string {
+	return ConfigTemplate(DefaultConfig())
+}
+
+// ConfigTemplate returns the app.toml snippet of c.
+func ConfigTemplate(c Config) string {
+	return fmt.Sprintf(`
+[metaprotocols]
+# Record the ExtensionData attached to committed transactions in a node-local
+# database, served by the metaprotocols Extensions query. The index does not
+# affect consensus state and only covers blocks processed while it is enabled.
+index_enabled = %t
+`, c.IndexEnabled)
+}
+
+// ReadConfig reads the indexer configuration from the app options.
+func ReadConfig(opts servertypes.AppOptions) (Config, error) {
+	cfg := DefaultConfig()
+	var err error
+	if v := opts.Get(flagIndexEnabled); v != nil {
+		if cfg.IndexEnabled, err = cast.ToBoolE(v); err != nil {
+			return cfg, err
+		}
+	}
+	return cfg, nil
+}
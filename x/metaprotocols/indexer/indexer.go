@@ -0,0 +1,145 @@
+package indexer
+
+import (
+	"context"
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	cmttypes "github.com/cometbft/cometbft/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+	storetypes "cosmossdk.io/store/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+// DBName is the name of the indexer database in the node's data directory.
+const DBName = "metaprotocols_index"
+
+// maxProtocolIDLength is the longest protocol ID that can be indexed.
+const maxProtocolIDLength = 255
+
+var _ storetypes.ABCIListener = (*Indexer)(nil)
+
+// Indexer is an ABCIListener recording the ExtensionData attached to the
+// successful transactions of every finalized block in its own database.
+// It never writes to the application state: the index is a node-local view
+// that can be enabled, disabled or dropped without affecting consensus.
+type Indexer struct {
+	db        dbm.DB
+	cdc       codec.BinaryCodec
+	txDecoder sdk.TxDecoder
+	logger    log.Logger
+
+	// pending holds the entries of the block being finalized until it is
+	// committed.
+	pending map[string][]byte
+}
+
+func NewIndexer(db dbm.DB, cdc codec.BinaryCodec, txDecoder sdk.TxDecoder, logger log.Logger) *Indexer {
+	return &Indexer{
+		db:        db,
+		cdc:       cdc,
+		txDecoder: txDecoder,
+		logger:    logger.With("module", "x/"+types.ModuleName),
+	}
+}
+
+// ListenFinalizeBlock collects the extensions of the successful transactions
+// of the block.
+func (idx *Indexer) ListenFinalizeBlock(_ context.Context, req abci.RequestFinalizeBlock, res abci.ResponseFinalizeBlock) error {
+	idx.pending = make(map[string][]byte)
+	for i, txBytes := range req.Txs {
+		if i >= len(res.TxResults) || res.TxResults[i].Code != abci.CodeTypeOK {
+			continue
+		}
+		tx, err := idx.txDecoder(txBytes)
+		if err != nil {
+			continue
+		}
+		if err := idx.indexTx(tx, txBytes, req.Height, i); err != nil {
+			idx.logger.Error("failed to index tx extensions", "height", req.Height, "tx", i, "err", err)
+		}
+	}
+	return nil
+}
+
+func (idx *Indexer) indexTx(tx sdk.Tx, txBytes []byte, height int64, txIndex int) error {
+	extTx, ok := tx.(authante.HasExtensionOptionsTx)
+	if !ok {
+		return nil
+	}
+	var options []*codectypes.Any
+	options = append(options, extTx.GetExtensionOptions()...)
+	options = append(options, extTx.GetNonCriticalExtensionOptions()...)
+	if len(options) == 0 {
+		return nil
+	}
+
+	var signer sdk.AccAddress
+	if sigTx, ok := tx.(authsigning.SigVerifiableTx); ok {
+		signers, err := sigTx.GetSigners()
+		if err != nil {
+			return err
+		}
+		if len(signers) > 0 {
+			signer = signers[0]
+		}
+	}
+	txHash := fmt.Sprintf("%X", cmttypes.Tx(txBytes).Hash())
+
+	for i, option := range options {
+		ext, ok := option.GetCachedValue().(*types.ExtensionData)
+		if !ok || len(ext.ProtocolId) > maxProtocolIDLength {
+			continue
+		}
+		record := types.ExtensionRecord{
+			ProtocolId:      ext.ProtocolId,
+			ProtocolVersion: ext.ProtocolVersion,
+			TxHash:          txHash,
+			Height:          height,
+			Signer:          signer.String(),
+		}
+		bz, err := idx.cdc.Marshal(&record)
+		if err != nil {
+			return err
+		}
+
+		suffix := recordSuffix(height, txIndex, i)
+		idx.pending[string(append(GetProtocolPrefix(ext.ProtocolId), suffix...))] = bz
+		if !signer.Empty() {
+			idx.pending[string(append(GetProtocolSignerPrefix(ext.ProtocolId, signer), suffix...))] = bz
+		}
+	}
+	return nil
+}
+
+// ListenCommit writes the entries of the committed block to the database.
+func (idx *Indexer) ListenCommit(_ context.Context, _ abci.ResponseCommit, _ []*storetypes.StoreKVPair) error {
+	if len(idx.pending) == 0 {
+		return nil
+	}
+
+	batch := idx.db.NewBatch()
+	defer batch.Close()
+	for key, value := range idx.pending {
+		if err := batch.Set([]byte(key), value); err != nil {
+			return err
+		}
+	}
+	idx.pending = nil
+	return batch.Write()
+}
+
+// Close closes the indexer database.
+func (idx *Indexer) Close() error {
+	return idx.db.Close()
+}
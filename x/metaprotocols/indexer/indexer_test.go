@@ -0,0 +1,104 @@
+package indexer_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	dbm "github.com/cosmos/cosmos-db"
+
+	"cosmossdk.io/log"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	authtx "github.com/cosmos/cosmos-sdk/x/auth/tx"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/metaprotocols/indexer"
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+func TestIndexer(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	txConfig := gaiaApp.GetTxConfig()
+	idx := indexer.NewIndexer(dbm.NewMemDB(), gaiaApp.AppCodec(), txConfig.TxDecoder(), log.NewNopLogger())
+	querier := indexer.NewQuerier(idx)
+
+	alice := sdk.AccAddress([]byte("alice_______________"))
+	bob := sdk.AccAddress([]byte("bob_________________"))
+
+	encodeTx := func(signer sdk.AccAddress, exts ...*types.ExtensionData) []byte {
+		txBuilder := txConfig.NewTxBuilder()
+		require.NoError(t, txBuilder.SetMsgs(banktypes.NewMsgSend(signer, signer, sdk.NewCoins(sdk.NewInt64Coin("uatom", 1)))))
+		anys := make([]*codectypes.Any, len(exts))
+		for i, ext := range exts {
+			extAny, err := codectypes.NewAnyWithValue(ext)
+			require.NoError(t, err)
+			anys[i] = extAny
+		}
+		txBuilder.(authtx.ExtensionOptionsTxBuilder).SetNonCriticalExtensionOptions(anys...)
+		bz, err := txConfig.TxEncoder()(txBuilder.GetTx())
+		require.NoError(t, err)
+		return bz
+	}
+	post := &types.ExtensionData{ProtocolId: "posts", ProtocolVersion: "1", Data: []byte("hello")}
+	other := &types.ExtensionData{ProtocolId: "other", ProtocolVersion: "2", Data: []byte("data")}
+
+	finalize := func(height int64, txs [][]byte, codes []uint32) {
+		results := make([]*abci.ExecTxResult, len(codes))
+		for i, code := range codes {
+			results[i] = &abci.ExecTxResult{Code: code}
+		}
+		err := idx.ListenFinalizeBlock(t.Context(),
+			abci.RequestFinalizeBlock{Height: height, Txs: txs},
+			abci.ResponseFinalizeBlock{TxResults: results})
+		require.NoError(t, err)
+	}
+
+	finalize(1, [][]byte{encodeTx(alice, post, other), encodeTx(bob, post)}, []uint32{0, 0})
+	// nothing is visible before the block is committed
+	resp, err := querier.Extensions(t.Context(), &types.QueryExtensionsRequest{ProtocolId: "posts"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Records)
+	require.NoError(t, idx.ListenCommit(t.Context(), abci.ResponseCommit{}, nil))
+
+	// failed txs are not indexed
+	finalize(2, [][]byte{encodeTx(alice, post), encodeTx(bob, post)}, []uint32{0, 5})
+	require.NoError(t, idx.ListenCommit(t.Context(), abci.ResponseCommit{}, nil))
+
+	resp, err = querier.Extensions(t.Context(), &types.QueryExtensionsRequest{ProtocolId: "posts"})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 3)
+	require.Equal(t, []int64{1, 1, 2}, []int64{resp.Records[0].Height, resp.Records[1].Height, resp.Records[2].Height})
+	require.Equal(t, alice.String(), resp.Records[0].Signer)
+	require.Equal(t, bob.String(), resp.Records[1].Signer)
+	require.Equal(t, "1", resp.Records[0].ProtocolVersion)
+	require.Len(t, resp.Records[0].TxHash, 64)
+
+	resp, err = querier.Extensions(t.Context(), &types.QueryExtensionsRequest{ProtocolId: "posts", Signer: alice.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 2)
+
+	resp, err = querier.Extensions(t.Context(), &types.QueryExtensionsRequest{
+		ProtocolId: "posts",
+		Pagination: &query.PageRequest{Limit: 2, CountTotal: true},
+	})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 2)
+	require.Equal(t, uint64(3), resp.Pagination.Total)
+
+	resp, err = querier.Extensions(t.Context(), &types.QueryExtensionsRequest{ProtocolId: "other"})
+	require.NoError(t, err)
+	require.Len(t, resp.Records, 1)
+	require.Equal(t, "2", resp.Records[0].ProtocolVersion)
+}
+
+func TestQuerierDisabled(t *testing.T) {
+	querier := indexer.NewQuerier(nil)
+	_, err := querier.Extensions(t.Context(), &types.QueryExtensionsRequest{ProtocolId: "posts"})
+	require.ErrorContains(t, err, "not enabled")
+}
@@ -0,0 +1,33 @@
+package indexer
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+var (
+	// ProtocolPrefix indexes the records of a protocol by height.
+	ProtocolPrefix = []byte{0x01}
+	// ProtocolSignerPrefix indexes the records of a protocol and signer by height.
+	ProtocolSignerPrefix = []byte{0x02}
+)
+
+// GetProtocolPrefix returns the prefix of the records of protocolID.
+func GetProtocolPrefix(protocolID string) []byte {
+	return append(ProtocolPrefix, address.MustLengthPrefix([]byte(protocolID))...)
+}
+
+// GetProtocolSignerPrefix returns the prefix of the records of protocolID
+// signed by signer.
+func GetProtocolSignerPrefix(protocolID string, signer sdk.AccAddress) []byte {
+	key := append(ProtocolSignerPrefix, address.MustLengthPrefix([]byte(protocolID))...)
+	return append(key, address.MustLengthPrefix(signer)...)
+}
+
+// recordSuffix orders the records under a prefix by height, then by their
+// position in the block.
+func recordSuffix(height int64, txIndex, extIndex int) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(height))
+	key = append(key, sdk.Uint64ToBigEndian(uint64(txIndex))...)
+	return append(key, sdk.Uint64ToBigEndian(uint64(extIndex))...)
+}
@@ -0,0 +1,65 @@
+package indexer
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/dbadapter"
+	"cosmossdk.io/store/prefix"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+// Querier serves the Extensions query from the indexer database; the
+// registry queries are served by the metaprotocols keeper. A nil indexer
+// means that indexing is disabled on this node.
+type Querier struct {
+	indexer *Indexer
+}
+
+func NewQuerier(indexer *Indexer) Querier {
+	return Querier{indexer: indexer}
+}
+
+// Extensions queries the recorded extensions of a protocol
+func (q Querier) Extensions(_ context.Context, req *types.QueryExtensionsRequest) (*types.QueryExtensionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if q.indexer == nil {
+		return nil, status.Error(codes.Unavailable, "the metaprotocols indexer is not enabled on this node")
+	}
+	if req.ProtocolId == "" || len(req.ProtocolId) > maxProtocolIDLength {
+		return nil, status.Error(codes.InvalidArgument, "invalid protocol id")
+	}
+
+	keyPrefix := GetProtocolPrefix(req.ProtocolId)
+	if req.Signer != "" {
+		signer, err := sdk.AccAddressFromBech32(req.Signer)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		keyPrefix = GetProtocolSignerPrefix(req.ProtocolId, signer)
+	}
+
+	store := prefix.NewStore(dbadapter.Store{DB: q.indexer.db}, keyPrefix)
+	var records []types.ExtensionRecord
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var record types.ExtensionRecord
+		if err := q.indexer.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+		records = append(records, record)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryExtensionsResponse{Records: records, Pagination: pageRes}, nil
+}
@@ -0,0 +1,32 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+// InitGenesis sets the metaprotocols registry for genesis
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+	for _, protocol := range data.Protocols {
+		if err := k.SetProtocol(ctx, protocol); err != nil {
+			panic(err)
+		}
+	}
+}
+
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+	protocols, err := k.GetAllProtocols(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params, protocols)
+}
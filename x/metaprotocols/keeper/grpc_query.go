@@ -0,0 +1,78 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/indexer"
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper.
+// The registry queries are served from the module state, and the Extensions
+// query from the node-local indexer.
+type Querier struct {
+	*Keeper
+	indexer.Querier
+}
+
+var _ types.QueryServer = Querier{}
+
+func NewQuerier(keeper *Keeper, indexerQuerier indexer.Querier) Querier {
+	return Querier{Keeper: keeper, Querier: indexerQuerier}
+}
+
+// Params queries the metaprotocols parameters
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// Protocol queries a registered protocol
+func (k Querier) Protocol(ctx context.Context, req *types.QueryProtocolRequest) (*types.QueryProtocolResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	protocol, found, err := k.GetProtocol(ctx, req.ProtocolId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, status.Errorf(codes.NotFound, "protocol %q is not registered", req.ProtocolId)
+	}
+	return &types.QueryProtocolResponse{Protocol: protocol}, nil
+}
+
+// Protocols queries all registered protocols
+func (k Querier) Protocols(ctx context.Context, req *types.QueryProtocolsRequest) (*types.QueryProtocolsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ProtocolPrefix)
+	var protocols []types.RegisteredProtocol
+	pageRes, err := query.Paginate(store, req.Pagination, func(_, value []byte) error {
+		var protocol types.RegisteredProtocol
+		if err := k.cdc.Unmarshal(value, &protocol); err != nil {
+			return err
+		}
+		protocols = append(protocols, protocol)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryProtocolsResponse{Protocols: protocols, Pagination: pageRes}, nil
+}
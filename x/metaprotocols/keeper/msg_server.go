@@ -0,0 +1,86 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+type msgServer struct {
+	*Keeper
+}
+
+// NewMsgServerImpl returns an implementation of the metaprotocols MsgServer interface
+// for the provided Keeper.
+func NewMsgServerImpl(keeper *Keeper) types.MsgServer {
+	return &msgServer{Keeper: keeper}
+}
+
+var _ types.MsgServer = msgServer{}
+
+func (k msgServer) checkAuthority(authority string) error {
+	if k.authority != authority {
+		return errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", k.authority, authority)
+	}
+	return nil
+}
+
+// UpdateParams defines a method to perform updating of params for the x/metaprotocols module.
+func (k msgServer) UpdateParams(ctx context.Context, msg *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Params.Validate(); err != nil {
+		return nil, err
+	}
+
+	// store params
+	if err := k.SetParams(ctx, msg.Params); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// RegisterProtocol registers a protocol in the x/metaprotocols registry.
+func (k msgServer) RegisterProtocol(ctx context.Context, msg *types.MsgRegisterProtocol) (*types.MsgRegisterProtocolResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Protocol.Validate(); err != nil {
+		return nil, err
+	}
+
+	if err := k.SetProtocol(ctx, msg.Protocol); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRegisterProtocolResponse{}, nil
+}
+
+// DeregisterProtocol removes a protocol from the x/metaprotocols registry.
+func (k msgServer) DeregisterProtocol(ctx context.Context, msg *types.MsgDeregisterProtocol) (*types.MsgDeregisterProtocolResponse, error) {
+	if err := k.checkAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	_, found, err := k.GetProtocol(ctx, msg.ProtocolId)
+	if err != nil {
+		return nil, err
+	}
+	if !found {
+		return nil, errorsmod.Wrapf(types.ErrProtocolNotFound, "%q", msg.ProtocolId)
+	}
+
+	if err := k.DeleteProtocol(ctx, msg.ProtocolId); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgDeregisterProtocolResponse{}, nil
+}
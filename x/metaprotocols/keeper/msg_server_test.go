@@ -0,0 +1,85 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	storetypes "cosmossdk.io/store/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/metaprotocols/indexer"
+	"github.com/cosmos/gaia/v29/x/metaprotocols/keeper"
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+func TestRegistry(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{})
+	k := gaiaApp.MetaprotocolsKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	querier := keeper.NewQuerier(k, indexer.NewQuerier(nil))
+	authority := k.GetAuthority()
+
+	protocol := types.RegisteredProtocol{
+		ProtocolId:  "posts",
+		MaxDataSize: 64,
+		JsonSchema:  `{"type": "object", "properties": {"text": {"type": "string"}}, "required": ["text"]}`,
+	}
+
+	// only the authority may register protocols
+	_, err := msgServer.RegisterProtocol(ctx, &types.MsgRegisterProtocol{Authority: sdk.AccAddress("invalid").String(), Protocol: protocol})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+
+	_, err = msgServer.RegisterProtocol(ctx, &types.MsgRegisterProtocol{Authority: authority, Protocol: types.RegisteredProtocol{ProtocolId: "posts"}})
+	require.ErrorIs(t, err, types.ErrInvalidProtocol)
+
+	_, err = msgServer.RegisterProtocol(ctx, &types.MsgRegisterProtocol{Authority: authority, Protocol: protocol})
+	require.NoError(t, err)
+
+	resp, err := querier.Protocol(ctx, &types.QueryProtocolRequest{ProtocolId: "posts"})
+	require.NoError(t, err)
+	require.Equal(t, protocol, resp.Protocol)
+
+	valid := &types.ExtensionData{ProtocolId: "posts", ProtocolVersion: "1", Data: []byte(`{"text": "gm"}`)}
+	invalid := &types.ExtensionData{ProtocolId: "posts", ProtocolVersion: "1", Data: []byte(`{"title": "gm"}`)}
+	unregistered := &types.ExtensionData{ProtocolId: "other", ProtocolVersion: "1", Data: []byte(`{}`)}
+
+	// nothing is rejected while the registry is not enforced
+	for _, ext := range []*types.ExtensionData{valid, invalid, unregistered} {
+		require.NoError(t, k.ValidateExtension(ctx, ext))
+	}
+
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: types.NewParams(true, nil)})
+	require.NoError(t, err)
+
+	require.NoError(t, k.ValidateExtension(ctx, valid))
+	require.ErrorIs(t, k.ValidateExtension(ctx, invalid), types.ErrInvalidData)
+	require.ErrorIs(t, k.ValidateExtension(ctx, unregistered), types.ErrUnregisteredProtocol)
+
+	// the validation is charged gas
+	gasCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	require.NoError(t, k.ValidateExtension(gasCtx, valid))
+	require.GreaterOrEqual(t, gasCtx.GasMeter().GasConsumed(), types.ValidateDataGasCost(valid.Data))
+
+	// a registration that is reverted does not change the cached schema
+	cacheCtx, _ := ctx.CacheContext()
+	stricter := protocol
+	stricter.JsonSchema = `{"type": "object", "properties": {"text": {"type": "string", "maxLength": 1}}}`
+	_, err = msgServer.RegisterProtocol(cacheCtx, &types.MsgRegisterProtocol{Authority: authority, Protocol: stricter})
+	require.NoError(t, err)
+	require.ErrorIs(t, k.ValidateExtension(cacheCtx, valid), types.ErrInvalidData)
+	require.NoError(t, k.ValidateExtension(ctx, valid))
+
+	_, err = msgServer.DeregisterProtocol(ctx, &types.MsgDeregisterProtocol{Authority: authority, ProtocolId: "posts"})
+	require.NoError(t, err)
+	require.ErrorIs(t, k.ValidateExtension(ctx, valid), types.ErrUnregisteredProtocol)
+
+	_, err = msgServer.DeregisterProtocol(ctx, &types.MsgDeregisterProtocol{Authority: authority, ProtocolId: "posts"})
+	require.ErrorIs(t, err, types.ErrProtocolNotFound)
+}
@@ -0,0 +1,141 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	storetypes "cosmossdk.io/store/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+// SetProtocol registers protocol, replacing any protocol with the same ID,
+// and compiles its schema.
+func (k Keeper) SetProtocol(ctx context.Context, protocol types.RegisteredProtocol) error {
+	validator, err := protocol.NewDataValidator()
+	if err != nil {
+		return err
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&protocol)
+	if err != nil {
+		return err
+	}
+	if err := store.Set(types.GetProtocolKey(protocol.ProtocolId), bz); err != nil {
+		return err
+	}
+	k.validators.set(protocol.ProtocolId, bz, validator)
+	return nil
+}
+
+// GetProtocol returns the registered protocol protocolID.
+func (k Keeper) GetProtocol(ctx context.Context, protocolID string) (protocol types.RegisteredProtocol, found bool, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetProtocolKey(protocolID))
+	if err != nil || bz == nil {
+		return protocol, false, err
+	}
+
+	err = k.cdc.Unmarshal(bz, &protocol)
+	return protocol, err == nil, err
+}
+
+// DeleteProtocol removes the registered protocol protocolID.
+func (k Keeper) DeleteProtocol(ctx context.Context, protocolID string) error {
+	store := k.storeService.OpenKVStore(ctx)
+	if err := store.Delete(types.GetProtocolKey(protocolID)); err != nil {
+		return err
+	}
+	k.validators.delete(protocolID)
+	return nil
+}
+
+// getDataValidator returns the validator of the registered protocol
+// protocolID, compiling its schema only if it is not cached yet.
+func (k Keeper) getDataValidator(ctx context.Context, protocolID string) (validator *types.DataValidator, found bool, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.GetProtocolKey(protocolID))
+	if err != nil || bz == nil {
+		return nil, false, err
+	}
+	if validator, ok := k.validators.get(protocolID, bz); ok {
+		return validator, true, nil
+	}
+
+	var protocol types.RegisteredProtocol
+	if err := k.cdc.Unmarshal(bz, &protocol); err != nil {
+		return nil, false, err
+	}
+	validator, err = protocol.NewDataValidator()
+	if err != nil {
+		return nil, false, err
+	}
+	k.validators.set(protocolID, bz, validator)
+	return validator, true, nil
+}
+
+// GetAllProtocols returns all registered protocols.
+func (k Keeper) GetAllProtocols(ctx context.Context) ([]types.RegisteredProtocol, error) {
+	store := k.storeService.OpenKVStore(ctx)
+	iterator, err := store.Iterator(types.ProtocolPrefix, storetypes.PrefixEndBytes(types.ProtocolPrefix))
+	if err != nil {
+		return nil, err
+	}
+	defer iterator.Close()
+
+	var protocols []types.RegisteredProtocol
+	for ; iterator.Valid(); iterator.Next() {
+		var protocol types.RegisteredProtocol
+		if err := k.cdc.Unmarshal(iterator.Value(), &protocol); err != nil {
+			return nil, err
+		}
+		protocols = append(protocols, protocol)
+	}
+	return protocols, nil
+}
+
+// ValidateExtension checks ext against the registry. It only rejects
+// extensions while the registry is enforced. The validation of the data of
+// registered protocols is charged types.ValidateDataGasCost.
+func (k Keeper) ValidateExtension(ctx context.Context, ext *types.ExtensionData) error {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+	if !params.EnforceRegistry {
+		return nil
+	}
+
+	validator, found, err := k.getDataValidator(ctx, ext.ProtocolId)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return errorsmod.Wrapf(types.ErrUnregisteredProtocol, "%q", ext.ProtocolId)
+	}
+	sdk.UnwrapSDKContext(ctx).GasMeter().ConsumeGas(types.ValidateDataGasCost(ext.Data), "metaprotocols data validation")
+	return validator.Validate(ext.Data)
+}
+
+// IsExtensionOptionAllowed returns true if governance allows option as a
+// critical extension option. ExtensionData is only allowed for registered
+// protocols.
+func (k Keeper) IsExtensionOptionAllowed(ctx context.Context, option *codectypes.Any) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	if !params.IsExtensionOptionAllowed(option.TypeUrl) {
+		return false, nil
+	}
+
+	ext, ok := option.GetCachedValue().(*types.ExtensionData)
+	if !ok {
+		return true, nil
+	}
+	_, found, err := k.GetProtocol(ctx, ext.ProtocolId)
+	return found, err
+}
@@ -0,0 +1,51 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"sync"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+// dataValidatorCache holds the compiled DataValidator of each registered
+// protocol, so that schemas are compiled once per registration rather than
+// for every transaction. Each entry records the hash of the registration it
+// was compiled from: a registration that differs in state, e.g. because the
+// transaction that cached it was reverted, is compiled again.
+type dataValidatorCache struct {
+	mu      sync.RWMutex
+	entries map[string]dataValidatorEntry
+}
+
+type dataValidatorEntry struct {
+	hash      [sha256.Size]byte
+	validator *types.DataValidator
+}
+
+func newDataValidatorCache() *dataValidatorCache {
+	return &dataValidatorCache{entries: make(map[string]dataValidatorEntry)}
+}
+
+// get returns the validator of protocolID compiled from the registration bz.
+func (c *dataValidatorCache) get(protocolID string, bz []byte) (*types.DataValidator, bool) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	entry, ok := c.entries[protocolID]
+	if !ok || entry.hash != sha256.Sum256(bz) {
+		return nil, false
+	}
+	return entry.validator, true
+}
+
+// set caches the validator of protocolID compiled from the registration bz.
+func (c *dataValidatorCache) set(protocolID string, bz []byte, validator *types.DataValidator) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.entries[protocolID] = dataValidatorEntry{hash: sha256.Sum256(bz), validator: validator}
+}
+
+func (c *dataValidatorCache) delete(protocolID string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.entries, protocolID)
+}
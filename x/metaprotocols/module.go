@@ -1,7 +1,9 @@
 package metaprotocols
 
 import (
+	"context"
 	"encoding/json"
+	"fmt"
 
 	"github.com/gorilla/mux"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
@@ -15,6 +17,9 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/types/module"
 
+	"github.com/cosmos/gaia/v29/x/metaprotocols/client/cli"
+	"github.com/cosmos/gaia/v29/x/metaprotocols/indexer"
+	"github.com/cosmos/gaia/v29/x/metaprotocols/keeper"
 	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
 )
 
@@ -32,20 +37,29 @@ func (a AppModuleBasic) Name() string {
 	return types.ModuleName
 }
 
-// DefaultGenesis is an empty object
-func (AppModuleBasic) DefaultGenesis(_ codec.JSONCodec) json.RawMessage {
-	return []byte("{}")
+// DefaultGenesis returns the default genesis state, an unenforced and empty
+// protocol registry
+func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
 }
 
-func (AppModuleBasic) ValidateGenesis(_ codec.JSONCodec, config client.TxEncodingConfig, _ json.RawMessage) error {
-	return nil
+func (AppModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return types.ValidateGenesis(&data)
 }
 
-func (a AppModule) ExportGenesis(_ sdk.Context, cdc codec.JSONCodec) json.RawMessage {
-	return a.DefaultGenesis(cdc)
+func (a AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(a.keeper.ExportGenesis(ctx))
 }
 
-func (a AppModule) InitGenesis(ctx sdk.Context, marshaler codec.JSONCodec, message json.RawMessage) []abci.ValidatorUpdate {
+func (a AppModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) []abci.ValidatorUpdate {
+	var genesisState types.GenesisState
+	cdc.MustUnmarshalJSON(data, &genesisState)
+	a.keeper.InitGenesis(ctx, &genesisState)
 	return nil
 }
 
@@ -59,28 +73,42 @@ func (a AppModuleBasic) RegisterRESTRoutes(_ client.Context, _ *mux.Router) {
 }
 
 func (a AppModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *runtime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
 }
 
 func (a AppModuleBasic) GetTxCmd() *cobra.Command {
 	return nil
 }
 
+// GetQueryCmd returns the query commands not generated by autocli, which
+// enhances it with the generated ones.
 func (a AppModuleBasic) GetQueryCmd() *cobra.Command {
-	return nil
+	return cli.GetQueryCmd()
 }
 
-func (a AppModuleBasic) RegisterLegacyAminoCodec(_ *codec.LegacyAmino) {
+func (a AppModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
 }
 
 type AppModule struct {
 	AppModuleBasic
+
+	keeper *keeper.Keeper
+	// indexer is nil unless the node enables the metaprotocols indexer
+	indexer *indexer.Indexer
 }
 
-func NewAppModule() *AppModule {
-	return &AppModule{}
+func NewAppModule(keeper *keeper.Keeper, indexer *indexer.Indexer) *AppModule {
+	return &AppModule{keeper: keeper, indexer: indexer}
 }
 
-func (a AppModule) RegisterServices(_ module.Configurator) {
+// RegisterServices registers the registry services. The Extensions query is
+// served from the node-local index rather than from the application state.
+func (a AppModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(a.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(a.keeper, indexer.NewQuerier(a.indexer)))
 }
 
 func (a AppModule) BeginBlock(_ sdk.Context) {
@@ -1,11 +1,26 @@
 package types
 
 import (
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/codec/legacy"
 	"github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/msgservice"
 	tx "github.com/cosmos/cosmos-sdk/types/tx"
 	"github.com/cosmos/cosmos-sdk/x/authz"
 )
 
+// RegisterLegacyAminoCodec registers the necessary x/metaprotocols interfaces
+// and concrete types on the provided LegacyAmino codec. These types are used
+// for Amino JSON serialization.
+func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	legacy.RegisterAminoMsg(cdc, &MsgUpdateParams{}, "gaia/mp/MsgUpdateParams")
+	legacy.RegisterAminoMsg(cdc, &MsgRegisterProtocol{}, "gaia/mp/MsgRegisterProtocol")
+	legacy.RegisterAminoMsg(cdc, &MsgDeregisterProtocol{}, "gaia/mp/MsgDeregisterProtocol")
+
+	cdc.RegisterConcrete(Params{}, "gaia/x/metaprotocols/Params", nil)
+}
+
 // RegisterInterfaces adds the x/metaprotocols module's interfaces to the provided InterfaceRegistry
 // The ExtendedData interface is registered so that the TxExtensionOptionsI can be properly encoded and decoded
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -16,4 +31,13 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		// the app does not interact with this message in any way but it performs an unmarshal which must not fail
 		&authz.MsgRevoke{},
 	)
+
+	registry.RegisterImplementations(
+		(*sdk.Msg)(nil),
+		&MsgUpdateParams{},
+		&MsgRegisterProtocol{},
+		&MsgDeregisterProtocol{},
+	)
+
+	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -0,0 +1,12 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/metaprotocols module sentinel errors
+var (
+	ErrUnregisteredProtocol = errors.Register(ModuleName, 2, "unregistered metaprotocol")
+	ErrDataTooLarge         = errors.Register(ModuleName, 3, "extension data too large")
+	ErrInvalidData          = errors.Register(ModuleName, 4, "extension data does not match the protocol schema")
+	ErrInvalidProtocol      = errors.Register(ModuleName, 5, "invalid metaprotocol")
+	ErrProtocolNotFound     = errors.Register(ModuleName, 6, "metaprotocol not found")
+)
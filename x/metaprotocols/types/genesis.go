@@ -0,0 +1,36 @@
+package types
+
+import (
+	"fmt"
+)
+
+func NewGenesisState(params Params, protocols []RegisteredProtocol) *GenesisState {
+	return &GenesisState{
+		Params:    params,
+		Protocols: protocols,
+	}
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func ValidateGenesis(gs *GenesisState) error {
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	ids := make(map[string]struct{}, len(gs.Protocols))
+	for _, protocol := range gs.Protocols {
+		if err := protocol.Validate(); err != nil {
+			return err
+		}
+		if _, ok := ids[protocol.ProtocolId]; ok {
+			return fmt.Errorf("duplicate protocol: %s", protocol.ProtocolId)
+		}
+		ids[protocol.ProtocolId] = struct{}{}
+	}
+	return nil
+}
@@ -2,4 +2,20 @@ package types
 
 const (
 	ModuleName = "metaprotocols"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the metaprotocols module
+	RouterKey = ModuleName
 )
+
+var (
+	ParamsKey      = []byte{0x01} // key for the parameters of the metaprotocols registry
+	ProtocolPrefix = []byte{0x02} // prefix for the registered protocols
+)
+
+// GetProtocolKey returns the key of the registered protocol protocolID
+func GetProtocolKey(protocolID string) []byte {
+	return append(ProtocolPrefix, []byte(protocolID)...)
+}
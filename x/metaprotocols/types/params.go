@@ -0,0 +1,46 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// NewParams creates a new Params instance
+func NewParams(enforceRegistry bool, allowedExtensionOptions []string) Params {
+	return Params{
+		EnforceRegistry:         enforceRegistry,
+		AllowedExtensionOptions: allowedExtensionOptions,
+	}
+}
+
+// DefaultParams returns a default set of parameters, with the registry not
+// enforced and no critical extension option allowed.
+func DefaultParams() Params {
+	return NewParams(false, nil)
+}
+
+// validate a set of params
+func (p Params) Validate() error {
+	typeURLs := make(map[string]struct{}, len(p.AllowedExtensionOptions))
+	for _, typeURL := range p.AllowedExtensionOptions {
+		if !strings.HasPrefix(typeURL, "/") || len(typeURL) == 1 {
+			return fmt.Errorf("invalid extension option type URL: %q", typeURL)
+		}
+		if _, ok := typeURLs[typeURL]; ok {
+			return fmt.Errorf("duplicate extension option type URL: %s", typeURL)
+		}
+		typeURLs[typeURL] = struct{}{}
+	}
+	return nil
+}
+
+// IsExtensionOptionAllowed returns true if typeURL is allowed as a critical
+// extension option.
+func (p Params) IsExtensionOptionAllowed(typeURL string) bool {
+	for _, allowed := range p.AllowedExtensionOptions {
+		if allowed == typeURL {
+			return true
+		}
+	}
+	return false
+}
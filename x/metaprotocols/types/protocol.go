@@ -0,0 +1,245 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/xeipuuv/gojsonschema"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protodesc"
+	"google.golang.org/protobuf/reflect/protoreflect"
+	"google.golang.org/protobuf/types/descriptorpb"
+	"google.golang.org/protobuf/types/dynamicpb"
+
+	errorsmod "cosmossdk.io/errors"
+)
+
+const (
+	// MaxProtocolIDLength is the maximum length of a registered protocol ID
+	MaxProtocolIDLength = 255
+	// ValidateDataGas is the gas charged to validate the data of an extension
+	ValidateDataGas uint64 = 2000
+	// ValidateDataGasPerByte is the gas charged per byte of validated extension data
+	ValidateDataGasPerByte uint64 = 20
+)
+
+// Validate performs a stateless validation of a registered protocol,
+// including the compilation of its schema.
+func (p RegisteredProtocol) Validate() error {
+	if p.ProtocolId == "" || len(p.ProtocolId) > MaxProtocolIDLength {
+		return errorsmod.Wrapf(ErrInvalidProtocol, "protocol id must be between 1 and %d bytes", MaxProtocolIDLength)
+	}
+	if p.MaxDataSize == 0 {
+		return errorsmod.Wrapf(ErrInvalidProtocol, "protocol %s: max data size must be positive", p.ProtocolId)
+	}
+	if p.JsonSchema != "" && len(p.FileDescriptorSet) > 0 {
+		return errorsmod.Wrapf(ErrInvalidProtocol, "protocol %s: json schema and file descriptor set are mutually exclusive", p.ProtocolId)
+	}
+	if (len(p.FileDescriptorSet) > 0) != (p.MessageType != "") {
+		return errorsmod.Wrapf(ErrInvalidProtocol, "protocol %s: file descriptor set and message type must be set together", p.ProtocolId)
+	}
+
+	_, err := p.NewDataValidator()
+	return err
+}
+
+// ValidateDataGasCost returns the gas charged to validate data against a
+// protocol, whether its schema is already compiled or not.
+func ValidateDataGasCost(data []byte) uint64 {
+	return ValidateDataGas + ValidateDataGasPerByte*uint64(len(data))
+}
+
+// ValidateData checks that data satisfies the size limit and the schema of
+// the protocol. It compiles the schema of the protocol on every call; use a
+// DataValidator to validate data repeatedly.
+func (p RegisteredProtocol) ValidateData(data []byte) error {
+	validator, err := p.NewDataValidator()
+	if err != nil {
+		return err
+	}
+	return validator.Validate(data)
+}
+
+// DataValidator validates data against a protocol whose schema has been
+// compiled once. It is safe for concurrent use.
+type DataValidator struct {
+	protocol RegisteredProtocol
+	schema   *gojsonschema.Schema
+	message  protoreflect.MessageDescriptor
+}
+
+// NewDataValidator compiles the JSON schema or the message descriptor of the
+// protocol into a DataValidator.
+func (p RegisteredProtocol) NewDataValidator() (*DataValidator, error) {
+	validator := &DataValidator{protocol: p}
+	if p.JsonSchema != "" {
+		schema, err := p.jsonSchema()
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidProtocol, "protocol %s: invalid json schema: %s", p.ProtocolId, err)
+		}
+		validator.schema = schema
+	}
+	if len(p.FileDescriptorSet) > 0 {
+		md, err := p.messageDescriptor()
+		if err != nil {
+			return nil, errorsmod.Wrapf(ErrInvalidProtocol, "protocol %s: invalid file descriptor set: %s", p.ProtocolId, err)
+		}
+		validator.message = md
+	}
+	return validator, nil
+}
+
+// Validate checks that data satisfies the size limit and the schema of the
+// protocol.
+func (v *DataValidator) Validate(data []byte) error {
+	p := v.protocol
+	if uint64(len(data)) > p.MaxDataSize {
+		return errorsmod.Wrapf(ErrDataTooLarge, "protocol %s allows %d bytes, got %d", p.ProtocolId, p.MaxDataSize, len(data))
+	}
+
+	switch {
+	case v.schema != nil:
+		result, err := v.schema.Validate(gojsonschema.NewBytesLoader(data))
+		if err != nil {
+			return errorsmod.Wrapf(ErrInvalidData, "protocol %s: %s", p.ProtocolId, err)
+		}
+		if !result.Valid() {
+			return errorsmod.Wrapf(ErrInvalidData, "protocol %s: %s", p.ProtocolId, result.Errors()[0])
+		}
+	case v.message != nil:
+		msg := dynamicpb.NewMessage(v.message)
+		if err := proto.Unmarshal(data, msg); err != nil {
+			return errorsmod.Wrapf(ErrInvalidData, "protocol %s: %s", p.ProtocolId, err)
+		}
+		if hasUnknownFields(msg) {
+			return errorsmod.Wrapf(ErrInvalidData, "protocol %s: data has fields unknown to %s", p.ProtocolId, p.MessageType)
+		}
+	}
+	return nil
+}
+
+// DataToJSON returns data as JSON. JSON data is returned as is, and data of
+// protocols registered with a message type is decoded as that message.
+func (p RegisteredProtocol) DataToJSON(data []byte) (json.RawMessage, error) {
+	if len(p.FileDescriptorSet) == 0 {
+		if !json.Valid(data) {
+			return nil, errorsmod.Wrapf(ErrInvalidData, "protocol %s: data is not json", p.ProtocolId)
+		}
+		return data, nil
+	}
+
+	md, err := p.messageDescriptor()
+	if err != nil {
+		return nil, errorsmod.Wrap(ErrInvalidProtocol, err.Error())
+	}
+	msg := dynamicpb.NewMessage(md)
+	if err := proto.Unmarshal(data, msg); err != nil {
+		return nil, errorsmod.Wrapf(ErrInvalidData, "protocol %s: %s", p.ProtocolId, err)
+	}
+	return protojson.Marshal(msg)
+}
+
+// jsonSchema compiles the JSON schema of the protocol. Schemas are compiled
+// as draft 7 and may only reference themselves, so that validation never
+// loads documents from outside of the chain state.
+func (p RegisteredProtocol) jsonSchema() (*gojsonschema.Schema, error) {
+	var doc interface{}
+	if err := json.Unmarshal([]byte(p.JsonSchema), &doc); err != nil {
+		return nil, err
+	}
+	if err := checkLocalRefs(doc); err != nil {
+		return nil, err
+	}
+
+	loader := gojsonschema.NewSchemaLoader()
+	loader.Draft = gojsonschema.Draft7
+	loader.AutoDetect = false
+	return loader.Compile(gojsonschema.NewGoLoader(doc))
+}
+
+// checkLocalRefs rejects identifiers and references to other documents.
+func checkLocalRefs(node interface{}) error {
+	switch v := node.(type) {
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			value := v[key]
+			if key == "$id" {
+				return fmt.Errorf("$id is not supported")
+			}
+			if ref, ok := value.(string); ok && key == "$ref" && !strings.HasPrefix(ref, "#") {
+				return fmt.Errorf("only local references are supported, got %q", ref)
+			}
+			if err := checkLocalRefs(value); err != nil {
+				return err
+			}
+		}
+	case []interface{}:
+		for _, value := range v {
+			if err := checkLocalRefs(value); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// messageDescriptor returns the descriptor of the protocol's message type
+// from its self-contained file descriptor set.
+func (p RegisteredProtocol) messageDescriptor() (protoreflect.MessageDescriptor, error) {
+	var set descriptorpb.FileDescriptorSet
+	if err := proto.Unmarshal(p.FileDescriptorSet, &set); err != nil {
+		return nil, err
+	}
+	files, err := protodesc.NewFiles(&set)
+	if err != nil {
+		return nil, err
+	}
+	desc, err := files.FindDescriptorByName(protoreflect.FullName(p.MessageType))
+	if err != nil {
+		return nil, err
+	}
+	md, ok := desc.(protoreflect.MessageDescriptor)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a message", p.MessageType)
+	}
+	return md, nil
+}
+
+// hasUnknownFields reports whether msg or any message nested in it carries
+// unknown fields.
+func hasUnknownFields(msg protoreflect.Message) bool {
+	if len(msg.GetUnknown()) > 0 {
+		return true
+	}
+	unknown := false
+	msg.Range(func(fd protoreflect.FieldDescriptor, v protoreflect.Value) bool {
+		switch {
+		case fd.IsMap():
+			if fd.MapValue().Message() != nil {
+				v.Map().Range(func(_ protoreflect.MapKey, mv protoreflect.Value) bool {
+					unknown = hasUnknownFields(mv.Message())
+					return !unknown
+				})
+			}
+		case fd.IsList():
+			if fd.Message() != nil {
+				list := v.List()
+				for i := 0; i < list.Len() && !unknown; i++ {
+					unknown = hasUnknownFields(list.Get(i).Message())
+				}
+			}
+		case fd.Message() != nil:
+			unknown = hasUnknownFields(v.Message())
+		}
+		return !unknown
+	})
+	return unknown
+}
@@ -0,0 +1,147 @@
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/encoding/protowire"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/descriptorpb"
+
+	"github.com/cosmos/gaia/v29/x/metaprotocols/types"
+)
+
+// postDescriptorSet returns a file descriptor set defining
+// message test.Post { string text = 1; }
+func postDescriptorSet(t *testing.T) []byte {
+	t.Helper()
+	set := &descriptorpb.FileDescriptorSet{
+		File: []*descriptorpb.FileDescriptorProto{{
+			Name:    proto.String("post.proto"),
+			Package: proto.String("test"),
+			Syntax:  proto.String("proto3"),
+			MessageType: []*descriptorpb.DescriptorProto{{
+				Name: proto.String("Post"),
+				Field: []*descriptorpb.FieldDescriptorProto{{
+					Name:     proto.String("text"),
+					JsonName: proto.String("text"),
+					Number:   proto.Int32(1),
+					Label:    descriptorpb.FieldDescriptorProto_LABEL_OPTIONAL.Enum(),
+					Type:     descriptorpb.FieldDescriptorProto_TYPE_STRING.Enum(),
+				}},
+			}},
+		}},
+	}
+	bz, err := proto.Marshal(set)
+	require.NoError(t, err)
+	return bz
+}
+
+func TestRegisteredProtocolValidate(t *testing.T) {
+	tests := []struct {
+		name     string
+		protocol types.RegisteredProtocol
+		expErr   string
+	}{
+		{
+			name:     "size only",
+			protocol: types.RegisteredProtocol{ProtocolId: "posts", MaxDataSize: 100},
+		},
+		{
+			name:     "json schema",
+			protocol: types.RegisteredProtocol{ProtocolId: "posts", MaxDataSize: 100, JsonSchema: `{"type": "object"}`},
+		},
+		{
+			name:     "proto descriptor",
+			protocol: types.RegisteredProtocol{ProtocolId: "posts", MaxDataSize: 100, FileDescriptorSet: postDescriptorSet(t), MessageType: "test.Post"},
+		},
+		{
+			name:     "empty protocol id",
+			protocol: types.RegisteredProtocol{MaxDataSize: 100},
+			expErr:   "protocol id",
+		},
+		{
+			name:     "zero max data size",
+			protocol: types.RegisteredProtocol{ProtocolId: "posts"},
+			expErr:   "max data size",
+		},
+		{
+			name:     "invalid json schema",
+			protocol: types.RegisteredProtocol{ProtocolId: "posts", MaxDataSize: 100, JsonSchema: `{"type": 1}`},
+			expErr:   "invalid json schema",
+		},
+		{
+			name: "remote json schema reference",
+			protocol: types.RegisteredProtocol{
+				ProtocolId: "posts", MaxDataSize: 100,
+				JsonSchema: `{"properties": {"a": {"$ref": "https://example.com/schema.json"}}}`,
+			},
+			expErr: "only local references",
+		},
+		{
+			name:     "unknown message type",
+			protocol: types.RegisteredProtocol{ProtocolId: "posts", MaxDataSize: 100, FileDescriptorSet: postDescriptorSet(t), MessageType: "test.Unknown"},
+			expErr:   "invalid file descriptor set",
+		},
+		{
+			name:     "message type without descriptor",
+			protocol: types.RegisteredProtocol{ProtocolId: "posts", MaxDataSize: 100, MessageType: "test.Post"},
+			expErr:   "must be set together",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.protocol.Validate()
+			if tc.expErr != "" {
+				require.ErrorIs(t, err, types.ErrInvalidProtocol)
+				require.ErrorContains(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestRegisteredProtocolValidateData(t *testing.T) {
+	jsonProtocol := types.RegisteredProtocol{
+		ProtocolId:  "posts",
+		MaxDataSize: 40,
+		JsonSchema:  `{"type": "object", "properties": {"text": {"type": "string"}}, "required": ["text"]}`,
+	}
+	protoProtocol := types.RegisteredProtocol{
+		ProtocolId:        "posts",
+		MaxDataSize:       40,
+		FileDescriptorSet: postDescriptorSet(t),
+		MessageType:       "test.Post",
+	}
+
+	post := protowire.AppendString(protowire.AppendTag(nil, 1, protowire.BytesType), "hello")
+	unknownField := protowire.AppendVarint(protowire.AppendTag(post, 2, protowire.VarintType), 1)
+
+	tests := []struct {
+		name     string
+		protocol types.RegisteredProtocol
+		data     []byte
+		expErr   error
+	}{
+		{"valid json", jsonProtocol, []byte(`{"text": "hello"}`), nil},
+		{"json not matching schema", jsonProtocol, []byte(`{"title": "hello"}`), types.ErrInvalidData},
+		{"not json", jsonProtocol, []byte(`hello`), types.ErrInvalidData},
+		{"too large", jsonProtocol, []byte(`{"text": "hello hello hello hello hello hello"}`), types.ErrDataTooLarge},
+		{"valid proto", protoProtocol, post, nil},
+		{"proto with unknown field", protoProtocol, unknownField, types.ErrInvalidData},
+		{"invalid proto", protoProtocol, []byte{0xff}, types.ErrInvalidData},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.protocol.ValidateData(tc.data)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
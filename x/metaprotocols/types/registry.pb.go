@@ -0,0 +1,822 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/metaprotocols/registry.proto
+
+package types
+
+import (
+	bytes "bytes"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters of the metaprotocols registry.
+type Params struct {
+	// enforce_registry rejects transactions carrying ExtensionData of an
+	// unregistered protocol or data that does not satisfy its protocol's
+	// constraints.
+	EnforceRegistry bool `protobuf:"varint,1,opt,name=enforce_registry,json=enforceRegistry,proto3" json:"enforce_registry,omitempty"`
+	// allowed_extension_options are the type URLs accepted as critical
+	// extension options of a transaction. A critical
+	// /gaia.metaprotocols.ExtensionData is only accepted for a registered
+	// protocol.
+	AllowedExtensionOptions []string `protobuf:"bytes,2,rep,name=allowed_extension_options,json=allowedExtensionOptions,proto3" json:"allowed_extension_options,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae578cb778591f3, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetEnforceRegistry() bool {
+	if m != nil {
+		return m.EnforceRegistry
+	}
+	return false
+}
+
+func (m *Params) GetAllowedExtensionOptions() []string {
+	if m != nil {
+		return m.AllowedExtensionOptions
+	}
+	return nil
+}
+
+// RegisteredProtocol is a metaprotocol registered by governance.
+type RegisteredProtocol struct {
+	// protocol_id is the ExtensionData protocol_id of the protocol.
+	ProtocolId string `protobuf:"bytes,1,opt,name=protocol_id,json=protocolId,proto3" json:"protocol_id,omitempty"`
+	// max_data_size is the maximum size in bytes of the ExtensionData data.
+	MaxDataSize uint64 `protobuf:"varint,2,opt,name=max_data_size,json=maxDataSize,proto3" json:"max_data_size,omitempty"`
+	// json_schema is an optional JSON schema the data must satisfy. It may only
+	// reference definitions within itself.
+	JsonSchema string `protobuf:"bytes,3,opt,name=json_schema,json=jsonSchema,proto3" json:"json_schema,omitempty"`
+	// file_descriptor_set is an optional serialized
+	// google.protobuf.FileDescriptorSet defining message_type. When set, the
+	// data must be a protobuf encoding of message_type without unknown fields.
+	FileDescriptorSet []byte `protobuf:"bytes,4,opt,name=file_descriptor_set,json=fileDescriptorSet,proto3" json:"file_descriptor_set,omitempty"`
+	// message_type is the full name of the protobuf message of the data.
+	MessageType string `protobuf:"bytes,5,opt,name=message_type,json=messageType,proto3" json:"message_type,omitempty"`
+}
+
+func (m *RegisteredProtocol) Reset()         { *m = RegisteredProtocol{} }
+func (m *RegisteredProtocol) String() string { return proto.CompactTextString(m) }
+func (*RegisteredProtocol) ProtoMessage()    {}
+func (*RegisteredProtocol) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bae578cb778591f3, []int{1}
+}
+func (m *RegisteredProtocol) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RegisteredProtocol) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredProtocol.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RegisteredProtocol) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredProtocol.Merge(m, src)
+}
+func (m *RegisteredProtocol) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredProtocol) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredProtocol.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RegisteredProtocol proto.InternalMessageInfo
+
+func (m *RegisteredProtocol) GetProtocolId() string {
+	if m != nil {
+		return m.ProtocolId
+	}
+	return ""
+}
+
+func (m *RegisteredProtocol) GetMaxDataSize() uint64 {
+	if m != nil {
+		return m.MaxDataSize
+	}
+	return 0
+}
+
+func (m *RegisteredProtocol) GetJsonSchema() string {
+	if m != nil {
+		return m.JsonSchema
+	}
+	return ""
+}
+
+func (m *RegisteredProtocol) GetFileDescriptorSet() []byte {
+	if m != nil {
+		return m.FileDescriptorSet
+	}
+	return nil
+}
+
+func (m *RegisteredProtocol) GetMessageType() string {
+	if m != nil {
+		return m.MessageType
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gaia.metaprotocols.Params")
+	proto.RegisterType((*RegisteredProtocol)(nil), "gaia.metaprotocols.RegisteredProtocol")
+}
+
+func init() { proto.RegisterFile("gaia/metaprotocols/registry.proto", fileDescriptor_bae578cb778591f3) }
+
+var fileDescriptor_bae578cb778591f3 = []byte{
+	// 387 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x90, 0x31, 0x8f, 0xd3, 0x30,
+	0x18, 0x86, 0xeb, 0xbb, 0x72, 0xe2, 0xdc, 0x43, 0x70, 0x06, 0x89, 0x70, 0x48, 0x69, 0x5a, 0x31,
+	0x04, 0x84, 0x92, 0x81, 0xad, 0x23, 0x2a, 0x48, 0x4c, 0x54, 0x29, 0x13, 0x8b, 0xe5, 0x26, 0x5f,
+	0x53, 0xa3, 0x38, 0x5f, 0x64, 0x1b, 0x91, 0xf6, 0x27, 0x30, 0xb1, 0xb0, 0xf3, 0x13, 0xf8, 0x19,
+	0x8c, 0x95, 0x58, 0x18, 0x51, 0x3b, 0xc0, 0xcf, 0x40, 0x71, 0x12, 0x24, 0xd0, 0x2d, 0x96, 0xfd,
+	0xbc, 0xaf, 0xde, 0xef, 0x7b, 0x4d, 0x27, 0xb9, 0x90, 0x22, 0x56, 0x60, 0x45, 0xa5, 0xd1, 0x62,
+	0x8a, 0x85, 0x89, 0x35, 0xe4, 0xd2, 0x58, 0xbd, 0x8d, 0x1c, 0x62, 0xac, 0xb1, 0x44, 0xff, 0x58,
+	0xae, 0xee, 0xe5, 0x98, 0xa3, 0x7b, 0xc6, 0xcd, 0xad, 0x75, 0x5e, 0x5d, 0x0a, 0x25, 0x4b, 0x8c,
+	0xdd, 0xd9, 0xa2, 0xe9, 0x67, 0x42, 0xcf, 0x16, 0x42, 0x0b, 0x65, 0xd8, 0x63, 0x7a, 0x07, 0xca,
+	0x35, 0xea, 0x14, 0x78, 0x3f, 0xc1, 0x23, 0x01, 0x09, 0x6f, 0x26, 0xb7, 0x3b, 0x9e, 0x74, 0x98,
+	0xcd, 0xe8, 0x03, 0x51, 0x14, 0xf8, 0x01, 0x32, 0x0e, 0xb5, 0x85, 0xd2, 0x48, 0x2c, 0x39, 0x56,
+	0x56, 0x62, 0x69, 0xbc, 0x93, 0xe0, 0x34, 0x3c, 0x4f, 0xee, 0x77, 0x86, 0x17, 0xbd, 0xfe, 0xba,
+	0x95, 0x67, 0x8f, 0x7e, 0x7f, 0x19, 0x93, 0x8f, 0xbf, 0xbe, 0x3e, 0x79, 0xe8, 0xaa, 0xd5, 0xff,
+	0x95, 0x6b, 0x97, 0x99, 0x7e, 0x27, 0x94, 0xb5, 0xe3, 0x40, 0x43, 0xb6, 0xe8, 0x64, 0x36, 0xa6,
+	0xa3, 0xde, 0xca, 0x65, 0xe6, 0xd6, 0x3b, 0x4f, 0x68, 0x8f, 0x5e, 0x65, 0x6c, 0x4a, 0x6f, 0x29,
+	0x51, 0xf3, 0x4c, 0x58, 0xc1, 0x8d, 0xdc, 0x81, 0x77, 0x12, 0x90, 0x70, 0x98, 0x8c, 0x94, 0xa8,
+	0xe7, 0xc2, 0x8a, 0xa5, 0xdc, 0x41, 0x13, 0xf2, 0xce, 0x60, 0xc9, 0x4d, 0xba, 0x01, 0x25, 0xbc,
+	0xd3, 0x36, 0xa4, 0x41, 0x4b, 0x47, 0x58, 0x44, 0xef, 0xae, 0x65, 0x01, 0x3c, 0x03, 0x93, 0x6a,
+	0x59, 0x59, 0xd4, 0xdc, 0x80, 0xf5, 0x86, 0x01, 0x09, 0x2f, 0x92, 0xcb, 0x46, 0x9a, 0xff, 0x55,
+	0x96, 0x60, 0xd9, 0x84, 0x5e, 0x28, 0x30, 0x46, 0xe4, 0xc0, 0xed, 0xb6, 0x02, 0xef, 0x86, 0x4b,
+	0x1c, 0x75, 0xec, 0xcd, 0xb6, 0x82, 0xd9, 0xb0, 0x69, 0xfd, 0xfc, 0xe5, 0xb7, 0x83, 0x4f, 0xf6,
+	0x07, 0x9f, 0xfc, 0x3c, 0xf8, 0xe4, 0xd3, 0xd1, 0x1f, 0xec, 0x8f, 0xfe, 0xe0, 0xc7, 0xd1, 0x1f,
+	0xbc, 0x7d, 0x9a, 0x4b, 0xbb, 0x79, 0xbf, 0x8a, 0x52, 0x54, 0x71, 0x8a, 0x46, 0xa1, 0x89, 0xaf,
+	0xfd, 0x9e, 0x26, 0xdf, 0xac, 0xce, 0x1c, 0x78, 0xf6, 0x67, 0x00, 0x0f, 0x01, 0x63, 0x34, 0x1e,
+	0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.EnforceRegistry != that1.EnforceRegistry {
+		return false
+	}
+	if len(this.AllowedExtensionOptions) != len(that1.AllowedExtensionOptions) {
+		return false
+	}
+	for i := range this.AllowedExtensionOptions {
+		if this.AllowedExtensionOptions[i] != that1.AllowedExtensionOptions[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *RegisteredProtocol) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisteredProtocol)
+	if !ok {
+		that2, ok := that.(RegisteredProtocol)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ProtocolId != that1.ProtocolId {
+		return false
+	}
+	if this.MaxDataSize != that1.MaxDataSize {
+		return false
+	}
+	if this.JsonSchema != that1.JsonSchema {
+		return false
+	}
+	if !bytes.Equal(this.FileDescriptorSet, that1.FileDescriptorSet) {
+		return false
+	}
+	if this.MessageType != that1.MessageType {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedExtensionOptions) > 0 {
+		for iNdEx := len(m.AllowedExtensionOptions) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedExtensionOptions[iNdEx])
+			copy(dAtA[i:], m.AllowedExtensionOptions[iNdEx])
+			i = encodeVarintRegistry(dAtA, i, uint64(len(m.AllowedExtensionOptions[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.EnforceRegistry {
+		i--
+		if m.EnforceRegistry {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredProtocol) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredProtocol) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredProtocol) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MessageType) > 0 {
+		i -= len(m.MessageType)
+		copy(dAtA[i:], m.MessageType)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.MessageType)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.FileDescriptorSet) > 0 {
+		i -= len(m.FileDescriptorSet)
+		copy(dAtA[i:], m.FileDescriptorSet)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.FileDescriptorSet)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.JsonSchema) > 0 {
+		i -= len(m.JsonSchema)
+		copy(dAtA[i:], m.JsonSchema)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.JsonSchema)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.MaxDataSize != 0 {
+		i = encodeVarintRegistry(dAtA, i, uint64(m.MaxDataSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ProtocolId) > 0 {
+		i -= len(m.ProtocolId)
+		copy(dAtA[i:], m.ProtocolId)
+		i = encodeVarintRegistry(dAtA, i, uint64(len(m.ProtocolId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRegistry(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRegistry(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EnforceRegistry {
+		n += 2
+	}
+	if len(m.AllowedExtensionOptions) > 0 {
+		for _, s := range m.AllowedExtensionOptions {
+			l = len(s)
+			n += 1 + l + sovRegistry(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *RegisteredProtocol) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ProtocolId)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	if m.MaxDataSize != 0 {
+		n += 1 + sovRegistry(uint64(m.MaxDataSize))
+	}
+	l = len(m.JsonSchema)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.FileDescriptorSet)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	l = len(m.MessageType)
+	if l > 0 {
+		n += 1 + l + sovRegistry(uint64(l))
+	}
+	return n
+}
+
+func sovRegistry(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRegistry(x uint64) (n int) {
+	return sovRegistry(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EnforceRegistry", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.EnforceRegistry = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedExtensionOptions", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedExtensionOptions = append(m.AllowedExtensionOptions, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredProtocol) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredProtocol: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredProtocol: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ProtocolId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ProtocolId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDataSize", wireType)
+			}
+			m.MaxDataSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDataSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field JsonSchema", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.JsonSchema = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FileDescriptorSet", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FileDescriptorSet = append(m.FileDescriptorSet[:0], dAtA[iNdEx:postIndex]...)
+			if m.FileDescriptorSet == nil {
+				m.FileDescriptorSet = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MessageType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MessageType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRegistry(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRegistry
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRegistry(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRegistry
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRegistry
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRegistry
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRegistry
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRegistry
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRegistry        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRegistry          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRegistry = fmt.Errorf("proto: unexpected end of group")
+)
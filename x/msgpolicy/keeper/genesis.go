@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/gaia/v29/x/msgpolicy/types"
+)
+
+// InitGenesis sets msgpolicy information for genesis
+func (k Keeper) InitGenesis(ctx context.Context, data *types.GenesisState) {
+	if err := k.SetParams(ctx, data.Params); err != nil {
+		panic(err)
+	}
+}
+
+func (k Keeper) ExportGenesis(ctx context.Context) *types.GenesisState {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	return types.NewGenesisState(params)
+}
@@ -0,0 +1,50 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/gaia/v29/x/msgpolicy/types"
+)
+
+// SetParams sets the x/msgpolicy module parameters.
+// CONTRACT: This method performs no validation of the parameters.
+func (k Keeper) SetParams(ctx context.Context, params types.Params) error {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+	return store.Set(types.ParamsKey, bz)
+}
+
+// GetParams gets the x/msgpolicy module parameters.
+func (k Keeper) GetParams(ctx context.Context) (params types.Params, err error) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := store.Get(types.ParamsKey)
+	if err != nil {
+		return params, err
+	}
+
+	if bz == nil {
+		return types.DefaultParams(), nil
+	}
+
+	err = k.cdc.Unmarshal(bz, &params)
+	return params, err
+}
+
+// GetEnabledPolicies returns the message policies that are currently enforced.
+func (k Keeper) GetEnabledPolicies(ctx context.Context) ([]types.MsgPolicy, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	enabled := make([]types.MsgPolicy, 0, len(params.Policies))
+	for _, policy := range params.Policies {
+		if policy.Enabled {
+			enabled = append(enabled, policy)
+		}
+	}
+	return enabled, nil
+}
@@ -0,0 +1,14 @@
+package types
+
+const (
+	// ModuleName is the name of the msgpolicy module
+	ModuleName = "msgpolicy"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the msgpolicy module
+	RouterKey = ModuleName
+)
+
+var ParamsKey = []byte{0x01} // key for the parameters of module x/msgpolicy
@@ -0,0 +1,844 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/msgpolicy/v1beta1/msgpolicy.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/msgpolicy module.
+type Params struct {
+	// policies are the message policies known to the chain. Only enabled
+	// policies are enforced.
+	Policies []MsgPolicy `protobuf:"bytes,1,rep,name=policies,proto3" json:"policies"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4c1c504aae440f5, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetPolicies() []MsgPolicy {
+	if m != nil {
+		return m.Policies
+	}
+	return nil
+}
+
+// MsgPolicy is a set of checks applied to every message whose type URL is
+// listed in type_urls, including messages nested in authz MsgExec or
+// dispatched by wasm contracts.
+type MsgPolicy struct {
+	// name uniquely identifies the policy
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	// type_urls are the message type URLs the policy applies to
+	TypeUrls []string `protobuf:"bytes,2,rep,name=type_urls,json=typeUrls,proto3" json:"type_urls,omitempty"`
+	// enabled toggles the enforcement of the policy
+	Enabled bool `protobuf:"varint,3,opt,name=enabled,proto3" json:"enabled,omitempty"`
+	// min_stake is the minimum amount of bonded tokens each signer of a
+	// matching message must have. Zero disables the check.
+	MinStake cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=min_stake,json=minStake,proto3,customtype=cosmossdk.io/math.Int" json:"min_stake"`
+	// max_per_tx is the maximum number of matching messages in a single
+	// transaction. Zero disables the check.
+	MaxPerTx uint32 `protobuf:"varint,5,opt,name=max_per_tx,json=maxPerTx,proto3" json:"max_per_tx,omitempty"`
+	// allowed_signers restricts the signers of matching messages to the given
+	// addresses. An empty list allows any signer.
+	AllowedSigners []string `protobuf:"bytes,6,rep,name=allowed_signers,json=allowedSigners,proto3" json:"allowed_signers,omitempty"`
+}
+
+func (m *MsgPolicy) Reset()         { *m = MsgPolicy{} }
+func (m *MsgPolicy) String() string { return proto.CompactTextString(m) }
+func (*MsgPolicy) ProtoMessage()    {}
+func (*MsgPolicy) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c4c1c504aae440f5, []int{1}
+}
+func (m *MsgPolicy) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPolicy) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPolicy.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPolicy) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPolicy.Merge(m, src)
+}
+func (m *MsgPolicy) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPolicy) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPolicy.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPolicy proto.InternalMessageInfo
+
+func (m *MsgPolicy) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgPolicy) GetTypeUrls() []string {
+	if m != nil {
+		return m.TypeUrls
+	}
+	return nil
+}
+
+func (m *MsgPolicy) GetEnabled() bool {
+	if m != nil {
+		return m.Enabled
+	}
+	return false
+}
+
+func (m *MsgPolicy) GetMaxPerTx() uint32 {
+	if m != nil {
+		return m.MaxPerTx
+	}
+	return 0
+}
+
+func (m *MsgPolicy) GetAllowedSigners() []string {
+	if m != nil {
+		return m.AllowedSigners
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gaia.msgpolicy.v1beta1.Params")
+	proto.RegisterType((*MsgPolicy)(nil), "gaia.msgpolicy.v1beta1.MsgPolicy")
+}
+
+func init() {
+	proto.RegisterFile("gaia/msgpolicy/v1beta1/msgpolicy.proto", fileDescriptor_c4c1c504aae440f5)
+}
+
+var fileDescriptor_c4c1c504aae440f5 = []byte{
+	// 431 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x51, 0x31, 0x6f, 0xd4, 0x30,
+	0x14, 0x3e, 0xf7, 0x8e, 0x23, 0x31, 0x02, 0x84, 0x55, 0xc0, 0x14, 0x94, 0x0b, 0x1d, 0x50, 0x54,
+	0xa9, 0x09, 0x85, 0xad, 0x5b, 0x33, 0xd1, 0xa1, 0xd2, 0x29, 0x07, 0x0b, 0x4b, 0xe4, 0x5c, 0x2c,
+	0xd7, 0x6a, 0x6c, 0x47, 0xb6, 0x0b, 0xe9, 0x5f, 0x40, 0x0c, 0xfc, 0x04, 0x46, 0xc6, 0x0e, 0xfd,
+	0x11, 0x1d, 0xab, 0x4e, 0x88, 0xa1, 0x42, 0x77, 0x43, 0xf9, 0x19, 0xc8, 0x49, 0xe8, 0x21, 0xc1,
+	0x12, 0xf9, 0x7d, 0xf9, 0xde, 0xf7, 0xde, 0xf7, 0x3d, 0xf8, 0x82, 0x11, 0x4e, 0x12, 0x61, 0x58,
+	0xad, 0x2a, 0x3e, 0x3f, 0x49, 0x3e, 0xec, 0x14, 0xd4, 0x92, 0x9d, 0x15, 0x12, 0xd7, 0x5a, 0x59,
+	0x85, 0x1e, 0x39, 0x5e, 0xbc, 0x42, 0x7b, 0xde, 0xc6, 0x3a, 0x53, 0x4c, 0xb5, 0x94, 0xc4, 0xbd,
+	0x3a, 0xf6, 0xc6, 0x03, 0x22, 0xb8, 0x54, 0x49, 0xfb, 0xed, 0xa1, 0x27, 0x73, 0x65, 0x84, 0x32,
+	0x79, 0xc7, 0xed, 0x8a, 0xee, 0xd7, 0xa6, 0x85, 0xe3, 0x29, 0xd1, 0x44, 0x18, 0xf4, 0x06, 0x7a,
+	0xad, 0x3e, 0xa7, 0x06, 0x83, 0x70, 0x18, 0xdd, 0x79, 0xf5, 0x3c, 0xfe, 0xff, 0xe0, 0xf8, 0xc0,
+	0xb0, 0x69, 0x8b, 0xa4, 0xfe, 0xf9, 0xd5, 0x64, 0xf0, 0xed, 0xfa, 0x74, 0x0b, 0x64, 0x37, 0xdd,
+	0xbb, 0xe1, 0xaf, 0xaf, 0x13, 0xf0, 0xe9, 0xfa, 0x74, 0xeb, 0x71, 0x6b, 0xb0, 0xf9, 0xcb, 0x62,
+	0x37, 0x6b, 0xf3, 0xf3, 0x1a, 0xf4, 0x6f, 0x44, 0x10, 0x82, 0x23, 0x49, 0x04, 0xc5, 0x20, 0x04,
+	0x91, 0x9f, 0xb5, 0x6f, 0xf4, 0x14, 0xfa, 0xf6, 0xa4, 0xa6, 0xf9, 0xb1, 0xae, 0x0c, 0x5e, 0x0b,
+	0x87, 0x91, 0x9f, 0x79, 0x0e, 0x78, 0xa7, 0x2b, 0x83, 0x30, 0xbc, 0x4d, 0x25, 0x29, 0x2a, 0x5a,
+	0xe2, 0x61, 0x08, 0x22, 0x2f, 0xfb, 0x53, 0xa2, 0x03, 0xe8, 0x0b, 0x2e, 0x73, 0x63, 0xc9, 0x11,
+	0xc5, 0x23, 0xa7, 0x97, 0xbe, 0x74, 0x2b, 0xfe, 0xb8, 0x9a, 0x3c, 0xec, 0x7c, 0x9b, 0xf2, 0x28,
+	0xe6, 0x2a, 0x11, 0xc4, 0x1e, 0xc6, 0xfb, 0xd2, 0x5e, 0x9e, 0x6d, 0xc3, 0x3e, 0x90, 0x7d, 0x69,
+	0x7b, 0x27, 0x82, 0xcb, 0x99, 0x53, 0x40, 0xcf, 0x20, 0x14, 0xa4, 0xc9, 0x6b, 0xaa, 0x73, 0xdb,
+	0xe0, 0x5b, 0x21, 0x88, 0xee, 0x66, 0x9e, 0x20, 0xcd, 0x94, 0xea, 0xb7, 0x0d, 0xda, 0x83, 0xf7,
+	0x49, 0x55, 0xa9, 0x8f, 0xb4, 0xcc, 0x0d, 0x67, 0x92, 0x6a, 0x83, 0xc7, 0x6e, 0xd3, 0x14, 0x5f,
+	0x9e, 0x6d, 0xaf, 0xf7, 0xaa, 0x7b, 0x65, 0xa9, 0xa9, 0x31, 0x33, 0xab, 0xb9, 0x64, 0xd9, 0xbd,
+	0xbe, 0x61, 0xd6, 0xf1, 0x77, 0x47, 0x2e, 0xaa, 0x34, 0x3d, 0x5f, 0x04, 0xe0, 0x62, 0x11, 0x80,
+	0x9f, 0x8b, 0x00, 0x7c, 0x59, 0x06, 0x83, 0x8b, 0x65, 0x30, 0xf8, 0xbe, 0x0c, 0x06, 0xef, 0x23,
+	0xc6, 0xed, 0xe1, 0x71, 0x11, 0xcf, 0x95, 0xe8, 0xef, 0x96, 0xfc, 0x93, 0xa9, 0x8b, 0xc5, 0x14,
+	0xe3, 0xf6, 0x9e, 0xaf, 0x7f, 0x0f, 0x00, 0x2e, 0x84, 0x02, 0xc4, 0x55, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Policies) != len(that1.Policies) {
+		return false
+	}
+	for i := range this.Policies {
+		if !this.Policies[i].Equal(&that1.Policies[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *MsgPolicy) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgPolicy)
+	if !ok {
+		that2, ok := that.(MsgPolicy)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Name != that1.Name {
+		return false
+	}
+	if len(this.TypeUrls) != len(that1.TypeUrls) {
+		return false
+	}
+	for i := range this.TypeUrls {
+		if this.TypeUrls[i] != that1.TypeUrls[i] {
+			return false
+		}
+	}
+	if this.Enabled != that1.Enabled {
+		return false
+	}
+	if !this.MinStake.Equal(that1.MinStake) {
+		return false
+	}
+	if this.MaxPerTx != that1.MaxPerTx {
+		return false
+	}
+	if len(this.AllowedSigners) != len(that1.AllowedSigners) {
+		return false
+	}
+	for i := range this.AllowedSigners {
+		if this.AllowedSigners[i] != that1.AllowedSigners[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for iNdEx := len(m.Policies) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Policies[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgpolicy(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPolicy) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPolicy) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPolicy) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AllowedSigners) > 0 {
+		for iNdEx := len(m.AllowedSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AllowedSigners[iNdEx])
+			copy(dAtA[i:], m.AllowedSigners[iNdEx])
+			i = encodeVarintMsgpolicy(dAtA, i, uint64(len(m.AllowedSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxPerTx != 0 {
+		i = encodeVarintMsgpolicy(dAtA, i, uint64(m.MaxPerTx))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.MinStake.Size()
+		i -= size
+		if _, err := m.MinStake.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgpolicy(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if m.Enabled {
+		i--
+		if m.Enabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TypeUrls) > 0 {
+		for iNdEx := len(m.TypeUrls) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.TypeUrls[iNdEx])
+			copy(dAtA[i:], m.TypeUrls[iNdEx])
+			i = encodeVarintMsgpolicy(dAtA, i, uint64(len(m.TypeUrls[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgpolicy(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgpolicy(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgpolicy(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Policies) > 0 {
+		for _, e := range m.Policies {
+			l = e.Size()
+			n += 1 + l + sovMsgpolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgPolicy) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgpolicy(uint64(l))
+	}
+	if len(m.TypeUrls) > 0 {
+		for _, s := range m.TypeUrls {
+			l = len(s)
+			n += 1 + l + sovMsgpolicy(uint64(l))
+		}
+	}
+	if m.Enabled {
+		n += 2
+	}
+	l = m.MinStake.Size()
+	n += 1 + l + sovMsgpolicy(uint64(l))
+	if m.MaxPerTx != 0 {
+		n += 1 + sovMsgpolicy(uint64(m.MaxPerTx))
+	}
+	if len(m.AllowedSigners) > 0 {
+		for _, s := range m.AllowedSigners {
+			l = len(s)
+			n += 1 + l + sovMsgpolicy(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovMsgpolicy(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgpolicy(x uint64) (n int) {
+	return sovMsgpolicy(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policies", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Policies = append(m.Policies, MsgPolicy{})
+			if err := m.Policies[len(m.Policies)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgPolicy) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgpolicy
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgPolicy: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgPolicy: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TypeUrls", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TypeUrls = append(m.TypeUrls, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Enabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Enabled = bool(v != 0)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinStake", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinStake.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPerTx", wireType)
+			}
+			m.MaxPerTx = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPerTx |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AllowedSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgpolicy
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AllowedSigners = append(m.AllowedSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgpolicy(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgpolicy
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMsgpolicy(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMsgpolicy
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMsgpolicy
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMsgpolicy
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMsgpolicy
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMsgpolicy
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMsgpolicy        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMsgpolicy          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMsgpolicy = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,58 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewParams creates a new Params instance
+func NewParams(policies ...MsgPolicy) Params {
+	return Params{
+		Policies: policies,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams()
+}
+
+// validate a set of params
+func (p Params) Validate() error {
+	names := make(map[string]struct{}, len(p.Policies))
+	for _, policy := range p.Policies {
+		if err := policy.Validate(); err != nil {
+			return err
+		}
+		if _, ok := names[policy.Name]; ok {
+			return fmt.Errorf("duplicate message policy: %s", policy.Name)
+		}
+		names[policy.Name] = struct{}{}
+	}
+	return nil
+}
+
+// Validate performs a stateless validation of a message policy
+func (p MsgPolicy) Validate() error {
+	if p.Name == "" {
+		return fmt.Errorf("message policy name cannot be empty")
+	}
+	if len(p.TypeUrls) == 0 {
+		return fmt.Errorf("message policy %s must apply to at least one type URL", p.Name)
+	}
+	for _, typeURL := range p.TypeUrls {
+		if len(typeURL) < 2 || typeURL[0] != '/' {
+			return fmt.Errorf("message policy %s: invalid type URL %q", p.Name, typeURL)
+		}
+	}
+	if p.MinStake.IsNil() || p.MinStake.IsNegative() {
+		return fmt.Errorf("message policy %s: min stake must be non-negative", p.Name)
+	}
+	for _, signer := range p.AllowedSigners {
+		if _, err := sdk.AccAddressFromBech32(signer); err != nil {
+			return fmt.Errorf("message policy %s: invalid allowed signer %s: %w", p.Name, signer, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,110 @@
+package pfmparams
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/cosmos/gaia/v29/x/pfmparams/keeper"
+)
+
+// keys of the forward metadata of the packet forward middleware memo
+const (
+	forwardKey = "forward"
+	channelKey = "channel"
+	retriesKey = "retries"
+	timeoutKey = "timeout"
+)
+
+var _ porttypes.IBCModule = IBCMiddleware{}
+
+// IBCMiddleware sets the retries on timeout and the timeout of the forward
+// metadata of the received ICS-20 packets to the x/pfmparams params of their
+// destination channel, unless the sender set them, before passing them to the
+// packet forward middleware it wraps. The other packets are passed unchanged.
+type IBCMiddleware struct {
+	porttypes.IBCModule
+	keeper *keeper.Keeper
+}
+
+// NewIBCMiddleware returns a new IBCMiddleware wrapping the packet forward
+// middleware app.
+func NewIBCMiddleware(app porttypes.IBCModule, k *keeper.Keeper) IBCMiddleware {
+	return IBCMiddleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im IBCMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	data, err := im.withForwardParams(ctx, packet.GetData())
+	if err != nil {
+		im.keeper.Logger(ctx).Error("failed to set the forward params of a packet", "error", err)
+	} else {
+		packet.Data = data
+	}
+	return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// withForwardParams returns the packet data with the forward params set in the
+// forward metadata of its memo, or data unchanged if it is not an ICS-20
+// packet to forward. Malformed memos are left to the packet forward
+// middleware to reject.
+func (im IBCMiddleware) withForwardParams(ctx sdk.Context, data []byte) ([]byte, error) {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(data, &packetData); err != nil {
+		return data, nil
+	}
+	var memo map[string]json.RawMessage
+	if err := json.Unmarshal([]byte(packetData.Memo), &memo); err != nil || !isSet(memo[forwardKey]) {
+		return data, nil
+	}
+	var forward map[string]json.RawMessage
+	if err := json.Unmarshal(memo[forwardKey], &forward); err != nil {
+		return data, nil
+	}
+	if isSet(forward[retriesKey]) && isSet(forward[timeoutKey]) {
+		return data, nil
+	}
+
+	var channelID string
+	if err := json.Unmarshal(forward[channelKey], &channelID); err != nil {
+		return data, nil
+	}
+	params, err := im.keeper.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	retriesOnTimeout, forwardTimeout, _ := params.ForwardParams(channelID)
+
+	if !isSet(forward[retriesKey]) {
+		if forward[retriesKey], err = json.Marshal(retriesOnTimeout); err != nil {
+			return nil, err
+		}
+	}
+	if !isSet(forward[timeoutKey]) {
+		if forward[timeoutKey], err = json.Marshal(forwardTimeout.String()); err != nil {
+			return nil, err
+		}
+	}
+	if memo[forwardKey], err = json.Marshal(forward); err != nil {
+		return nil, err
+	}
+	bz, err := json.Marshal(memo)
+	if err != nil {
+		return nil, err
+	}
+	packetData.Memo = string(bz)
+	return packetData.GetBytes(), nil
+}
+
+// isSet returns true if the JSON value is neither missing nor null.
+func isSet(value json.RawMessage) bool {
+	return len(value) > 0 && string(value) != "null"
+}
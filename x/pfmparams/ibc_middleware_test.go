@@ -0,0 +1,95 @@
+package pfmparams_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/pfmparams"
+	"github.com/cosmos/gaia/v29/x/pfmparams/types"
+)
+
+// app records the data of the received packets.
+type app struct {
+	porttypes.IBCModule
+	data []byte
+}
+
+func (a *app) OnRecvPacket(_ sdk.Context, _ string, packet channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	a.data = packet.GetData()
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func TestOnRecvPacket(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	require.NoError(t, gaiaApp.PFMParamsKeeper.SetParams(ctx, types.NewParams(2, 30*time.Minute, types.ChannelOverride{
+		ChannelId:        "channel-1",
+		RetriesOnTimeout: 5,
+		ForwardTimeout:   time.Hour,
+	})))
+
+	a := &app{}
+	middleware := pfmparams.NewIBCMiddleware(a, gaiaApp.PFMParamsKeeper)
+	recv := func(data []byte) []byte {
+		require.True(t, middleware.OnRecvPacket(ctx, transfertypes.V1, channeltypes.Packet{Data: data}, nil).Success())
+		return a.data
+	}
+	transfer := func(memo string) []byte {
+		return transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "cosmos1receiver", memo).GetBytes()
+	}
+
+	for _, tc := range []struct {
+		name    string
+		memo    string
+		expMemo string
+	}{
+		{
+			name:    "defaults",
+			memo:    `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-0"}}`,
+			expMemo: `{"forward":{"channel":"channel-0","port":"transfer","receiver":"osmo1receiver","retries":2,"timeout":"30m0s"}}`,
+		},
+		{
+			name:    "channel override",
+			memo:    `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1","next":{"wasm":{"contract":"osmo1contract"}}},"other":1}`,
+			expMemo: `{"forward":{"channel":"channel-1","next":{"wasm":{"contract":"osmo1contract"}},"port":"transfer","receiver":"osmo1receiver","retries":5,"timeout":"1h0m0s"},"other":1}`,
+		},
+		{
+			name:    "set by the sender",
+			memo:    `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1","retries":0,"timeout":"10m"}}`,
+			expMemo: `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1","retries":0,"timeout":"10m"}}`,
+		},
+		{
+			name:    "timeout set by the sender",
+			memo:    `{"forward":{"receiver":"osmo1receiver","port":"transfer","channel":"channel-1","retries":null,"timeout":600000000000}}`,
+			expMemo: `{"forward":{"channel":"channel-1","port":"transfer","receiver":"osmo1receiver","retries":5,"timeout":600000000000}}`,
+		},
+		{
+			name:    "no forward",
+			memo:    `{"wasm":{"contract":"cosmos1contract"}}`,
+			expMemo: `{"wasm":{"contract":"cosmos1contract"}}`,
+		},
+		{name: "not JSON", memo: "hello", expMemo: "hello"},
+		{name: "no memo"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			require.Equal(t, transfer(tc.expMemo), recv(transfer(tc.memo)))
+		})
+	}
+
+	// other packets are passed unchanged
+	data := []byte(`{"forward":{"channel":"channel-0"}}`)
+	require.Equal(t, data, recv(data))
+	require.Equal(t, []byte{0x0a, 0x01}, recv([]byte{0x0a, 0x01}))
+}
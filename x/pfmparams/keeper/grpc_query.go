@@ -0,0 +1,53 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+
+	"github.com/cosmos/gaia/v29/x/pfmparams/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries the pfmparams parameters
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// ForwardParams queries the forward params of the packets forwarded on a channel
+func (k Querier) ForwardParams(ctx context.Context, req *types.QueryForwardParamsRequest) (*types.QueryForwardParamsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if err := host.ChannelIdentifierValidator(req.ChannelId); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	retriesOnTimeout, forwardTimeout, overridden := params.ForwardParams(req.ChannelId)
+	return &types.QueryForwardParamsResponse{
+		RetriesOnTimeout: retriesOnTimeout,
+		ForwardTimeout:   forwardTimeout,
+		Overridden:       overridden,
+	}, nil
+}
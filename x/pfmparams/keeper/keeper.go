@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/pfmparams/types"
+)
+
+// Keeper of the x/pfmparams store
+type Keeper struct {
+	storeService storetypes.KVStoreService
+	cdc          codec.Codec
+	authority    string
+}
+
+// NewKeeper creates a new pfmparams Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	storeService storetypes.KVStoreService,
+	authority string,
+) *Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+
+	return &Keeper{
+		storeService: storeService,
+		cdc:          cdc,
+		authority:    authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the x/pfmparams module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
@@ -0,0 +1,80 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/pfmparams/keeper"
+	"github.com/cosmos/gaia/v29/x/pfmparams/types"
+)
+
+func TestForwardParams(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	k := gaiaApp.PFMParamsKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	querier := keeper.NewQuerier(k)
+	authority := k.GetAuthority()
+
+	// the defaults apply to every channel
+	defaults := types.DefaultParams()
+	res, err := querier.ForwardParams(ctx, &types.QueryForwardParamsRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryForwardParamsResponse{
+		RetriesOnTimeout: 0,
+		ForwardTimeout:   defaults.ForwardTimeout,
+	}, res)
+	_, err = querier.ForwardParams(ctx, &types.QueryForwardParamsRequest{ChannelId: "0"})
+	require.Error(t, err)
+
+	params := types.NewParams(2, 30*time.Minute, types.ChannelOverride{
+		ChannelId:        "channel-1",
+		RetriesOnTimeout: 5,
+		ForwardTimeout:   time.Hour,
+	})
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress("other").String(), Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	for _, tc := range []struct {
+		params types.Params
+		expErr string
+	}{
+		{params: types.NewParams(256, time.Hour), expErr: "retries on timeout must not exceed 255: 256"},
+		{params: types.NewParams(1, 0), expErr: "forward timeout must be positive: 0s"},
+		{
+			params: types.NewParams(1, time.Hour, types.ChannelOverride{ChannelId: "channel-1", ForwardTimeout: -time.Second}),
+			expErr: "invalid channel override channel-1: forward timeout must be positive: -1s",
+		},
+		{
+			params: types.NewParams(1, time.Hour,
+				types.ChannelOverride{ChannelId: "channel-1", ForwardTimeout: time.Hour},
+				types.ChannelOverride{ChannelId: "channel-1", ForwardTimeout: time.Minute},
+			),
+			expErr: "duplicate channel override: channel-1",
+		},
+	} {
+		_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: tc.params})
+		require.EqualError(t, err, tc.expErr)
+	}
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	paramsRes, err := querier.Params(ctx, &types.QueryParamsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, params, paramsRes.Params)
+	res, err = querier.ForwardParams(ctx, &types.QueryForwardParamsRequest{ChannelId: "channel-0"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryForwardParamsResponse{RetriesOnTimeout: 2, ForwardTimeout: 30 * time.Minute}, res)
+	res, err = querier.ForwardParams(ctx, &types.QueryForwardParamsRequest{ChannelId: "channel-1"})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryForwardParamsResponse{RetriesOnTimeout: 5, ForwardTimeout: time.Hour, Overridden: true}, res)
+
+	require.Equal(t, types.NewGenesisState(params), k.ExportGenesis(ctx))
+}
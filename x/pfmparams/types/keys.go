@@ -0,0 +1,14 @@
+package types
+
+const (
+	// ModuleName is the name of the pfmparams module
+	ModuleName = "pfmparams"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the pfmparams module
+	RouterKey = ModuleName
+)
+
+var ParamsKey = []byte{0x01} // key for the parameters of module x/pfmparams
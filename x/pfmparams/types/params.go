@@ -0,0 +1,69 @@
+package types
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	pfmrouterkeeper "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/keeper"
+
+	host "github.com/cosmos/ibc-go/v10/modules/core/24-host"
+)
+
+// NewParams creates a new Params instance
+func NewParams(retriesOnTimeout uint32, forwardTimeout time.Duration, channelOverrides ...ChannelOverride) Params {
+	return Params{
+		RetriesOnTimeout: retriesOnTimeout,
+		ForwardTimeout:   forwardTimeout,
+		ChannelOverrides: channelOverrides,
+	}
+}
+
+// DefaultParams returns a default set of parameters: no retries and the
+// default timeout of the packet forward middleware.
+func DefaultParams() Params {
+	return NewParams(0, pfmrouterkeeper.DefaultForwardTransferPacketTimeoutTimestamp)
+}
+
+// validate a set of params
+func (p Params) Validate() error {
+	if err := validateForwardParams(p.RetriesOnTimeout, p.ForwardTimeout); err != nil {
+		return err
+	}
+	channels := make(map[string]struct{}, len(p.ChannelOverrides))
+	for _, override := range p.ChannelOverrides {
+		if err := host.ChannelIdentifierValidator(override.ChannelId); err != nil {
+			return fmt.Errorf("invalid channel override: %w", err)
+		}
+		if _, ok := channels[override.ChannelId]; ok {
+			return fmt.Errorf("duplicate channel override: %s", override.ChannelId)
+		}
+		channels[override.ChannelId] = struct{}{}
+		if err := validateForwardParams(override.RetriesOnTimeout, override.ForwardTimeout); err != nil {
+			return fmt.Errorf("invalid channel override %s: %w", override.ChannelId, err)
+		}
+	}
+	return nil
+}
+
+// ForwardParams returns the retries on timeout and the timeout of the
+// packets forwarded on channelID, and whether they are those of a channel
+// override.
+func (p Params) ForwardParams(channelID string) (retriesOnTimeout uint32, forwardTimeout time.Duration, overridden bool) {
+	for _, override := range p.ChannelOverrides {
+		if override.ChannelId == channelID {
+			return override.RetriesOnTimeout, override.ForwardTimeout, true
+		}
+	}
+	return p.RetriesOnTimeout, p.ForwardTimeout, false
+}
+
+func validateForwardParams(retriesOnTimeout uint32, forwardTimeout time.Duration) error {
+	if retriesOnTimeout > math.MaxUint8 {
+		return fmt.Errorf("retries on timeout must not exceed %d: %d", math.MaxUint8, retriesOnTimeout)
+	}
+	if forwardTimeout <= 0 {
+		return fmt.Errorf("forward timeout must be positive: %s", forwardTimeout)
+	}
+	return nil
+}
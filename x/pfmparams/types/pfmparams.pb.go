@@ -0,0 +1,768 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/pfmparams/v1beta1/pfmparams.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the x/pfmparams module.
+type Params struct {
+	// retries_on_timeout is the number of times a packet forwarded by the
+	// packet forward middleware is sent again when it times out, before the
+	// transfer is refunded. It must not exceed 255.
+	RetriesOnTimeout uint32 `protobuf:"varint,1,opt,name=retries_on_timeout,json=retriesOnTimeout,proto3" json:"retries_on_timeout,omitempty"`
+	// forward_timeout is the timeout of the packets forwarded by the packet
+	// forward middleware, from the block time of the forward.
+	ForwardTimeout time.Duration `protobuf:"bytes,2,opt,name=forward_timeout,json=forwardTimeout,proto3,stdduration" json:"forward_timeout"`
+	// channel_overrides replace retries_on_timeout and forward_timeout for the
+	// packets forwarded on their channel.
+	ChannelOverrides []ChannelOverride `protobuf:"bytes,3,rep,name=channel_overrides,json=channelOverrides,proto3" json:"channel_overrides"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_974d29cd62c113c3, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetRetriesOnTimeout() uint32 {
+	if m != nil {
+		return m.RetriesOnTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetForwardTimeout() time.Duration {
+	if m != nil {
+		return m.ForwardTimeout
+	}
+	return 0
+}
+
+func (m *Params) GetChannelOverrides() []ChannelOverride {
+	if m != nil {
+		return m.ChannelOverrides
+	}
+	return nil
+}
+
+// ChannelOverride defines the forward params of the packets forwarded on a
+// destination channel.
+type ChannelOverride struct {
+	// channel_id is the channel the packets are forwarded on
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// retries_on_timeout is the number of times a packet forwarded on the
+	// channel is sent again when it times out. It must not exceed 255.
+	RetriesOnTimeout uint32 `protobuf:"varint,2,opt,name=retries_on_timeout,json=retriesOnTimeout,proto3" json:"retries_on_timeout,omitempty"`
+	// forward_timeout is the timeout of the packets forwarded on the channel.
+	ForwardTimeout time.Duration `protobuf:"bytes,3,opt,name=forward_timeout,json=forwardTimeout,proto3,stdduration" json:"forward_timeout"`
+}
+
+func (m *ChannelOverride) Reset()         { *m = ChannelOverride{} }
+func (m *ChannelOverride) String() string { return proto.CompactTextString(m) }
+func (*ChannelOverride) ProtoMessage()    {}
+func (*ChannelOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_974d29cd62c113c3, []int{1}
+}
+func (m *ChannelOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelOverride.Merge(m, src)
+}
+func (m *ChannelOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelOverride proto.InternalMessageInfo
+
+func (m *ChannelOverride) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelOverride) GetRetriesOnTimeout() uint32 {
+	if m != nil {
+		return m.RetriesOnTimeout
+	}
+	return 0
+}
+
+func (m *ChannelOverride) GetForwardTimeout() time.Duration {
+	if m != nil {
+		return m.ForwardTimeout
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gaia.pfmparams.v1beta1.Params")
+	proto.RegisterType((*ChannelOverride)(nil), "gaia.pfmparams.v1beta1.ChannelOverride")
+}
+
+func init() {
+	proto.RegisterFile("gaia/pfmparams/v1beta1/pfmparams.proto", fileDescriptor_974d29cd62c113c3)
+}
+
+var fileDescriptor_974d29cd62c113c3 = []byte{
+	// 383 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x91, 0x3d, 0x6f, 0xda, 0x40,
+	0x1c, 0xc6, 0x7d, 0x50, 0x21, 0x71, 0x88, 0x02, 0x56, 0xd5, 0x52, 0xa4, 0x1a, 0x8b, 0xa1, 0xb5,
+	0x50, 0x75, 0x27, 0xe8, 0xc6, 0xe8, 0x76, 0xe9, 0x44, 0x8b, 0x3a, 0x75, 0xb1, 0xce, 0xf6, 0x61,
+	0x4e, 0xc2, 0x3e, 0xeb, 0x7c, 0x26, 0xc9, 0x57, 0x88, 0x32, 0x64, 0xcc, 0x98, 0x31, 0x23, 0x5b,
+	0xbe, 0x02, 0x23, 0x63, 0xa6, 0x24, 0x82, 0x81, 0x7c, 0x8c, 0xc8, 0x2f, 0x84, 0xbc, 0x4a, 0x91,
+	0xb2, 0x58, 0xf6, 0xf3, 0x7f, 0xfc, 0xdc, 0xf3, 0xff, 0x1d, 0xfc, 0xea, 0x11, 0x46, 0x70, 0x38,
+	0xf6, 0x43, 0x22, 0x88, 0x1f, 0xe1, 0x59, 0xcf, 0xa6, 0x92, 0xf4, 0x76, 0x0a, 0x0a, 0x05, 0x97,
+	0x5c, 0xfd, 0x98, 0xf8, 0xd0, 0x4e, 0xcd, 0x7d, 0xad, 0x0f, 0x1e, 0xf7, 0x78, 0x6a, 0xc1, 0xc9,
+	0x5b, 0xe6, 0x6e, 0x69, 0x1e, 0xe7, 0xde, 0x94, 0xe2, 0xf4, 0xcb, 0x8e, 0xc7, 0xd8, 0x8d, 0x05,
+	0x91, 0x8c, 0x07, 0xf9, 0xbc, 0x41, 0x7c, 0x16, 0x70, 0x9c, 0x3e, 0x33, 0xa9, 0x73, 0x54, 0x80,
+	0xa5, 0x3f, 0x69, 0xb6, 0xfa, 0x1d, 0xaa, 0x82, 0x4a, 0xc1, 0x68, 0x64, 0xf1, 0xc0, 0x92, 0xcc,
+	0xa7, 0x3c, 0x96, 0x4d, 0xa0, 0x03, 0xa3, 0x3a, 0xaa, 0xe7, 0x93, 0x61, 0xf0, 0x2f, 0xd3, 0xd5,
+	0xbf, 0xb0, 0x36, 0xe6, 0x62, 0x8f, 0x08, 0xf7, 0xce, 0x5a, 0xd0, 0x81, 0x51, 0xe9, 0x7f, 0x46,
+	0x59, 0x0b, 0xb4, 0x6d, 0x81, 0x7e, 0xe5, 0x2d, 0xcc, 0xea, 0xe2, 0xb2, 0xad, 0x9c, 0x5c, 0xb5,
+	0xc1, 0xd9, 0x66, 0xde, 0x05, 0xa3, 0xf7, 0x79, 0xc0, 0x36, 0xd2, 0x82, 0x0d, 0x67, 0x42, 0x82,
+	0x80, 0x4e, 0x2d, 0x3e, 0xa3, 0x42, 0x30, 0x97, 0x46, 0xcd, 0xa2, 0x5e, 0x34, 0x2a, 0xfd, 0x6f,
+	0xe8, 0x79, 0x10, 0xe8, 0x67, 0xf6, 0xc3, 0x30, 0xf7, 0x9b, 0xe5, 0xe4, 0x88, 0x2c, 0xbe, 0xee,
+	0x3c, 0x9c, 0x45, 0x03, 0xfd, 0xe6, 0xb4, 0x0d, 0x0e, 0x37, 0xf3, 0xee, 0xa7, 0x14, 0xff, 0xfe,
+	0xbd, 0x0b, 0xc8, 0x18, 0x74, 0xce, 0x01, 0xac, 0x3d, 0x8a, 0x54, 0xbf, 0x40, 0xb8, 0xad, 0xc5,
+	0xdc, 0x94, 0x47, 0x79, 0x54, 0xce, 0x95, 0xdf, 0xee, 0x0b, 0xd8, 0x0a, 0xaf, 0xc7, 0x56, 0x7c,
+	0x1b, 0xb6, 0xc1, 0xbb, 0x64, 0x2b, 0xd3, 0x5c, 0xac, 0x34, 0xb0, 0x5c, 0x69, 0xe0, 0x7a, 0xa5,
+	0x81, 0xe3, 0xb5, 0xa6, 0x2c, 0xd7, 0x9a, 0x72, 0xb1, 0xd6, 0x94, 0xff, 0x86, 0xc7, 0xe4, 0x24,
+	0xb6, 0x91, 0xc3, 0x7d, 0xec, 0xf0, 0xc8, 0xe7, 0x11, 0x7e, 0xb2, 0xbe, 0x3c, 0x08, 0x69, 0x64,
+	0x97, 0xd2, 0xb3, 0x7f, 0xdc, 0x0e, 0x00, 0x28, 0x7c, 0xe3, 0x63, 0x9e, 0x02, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.RetriesOnTimeout != that1.RetriesOnTimeout {
+		return false
+	}
+	if this.ForwardTimeout != that1.ForwardTimeout {
+		return false
+	}
+	if len(this.ChannelOverrides) != len(that1.ChannelOverrides) {
+		return false
+	}
+	for i := range this.ChannelOverrides {
+		if !this.ChannelOverrides[i].Equal(&that1.ChannelOverrides[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *ChannelOverride) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ChannelOverride)
+	if !ok {
+		that2, ok := that.(ChannelOverride)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ChannelId != that1.ChannelId {
+		return false
+	}
+	if this.RetriesOnTimeout != that1.RetriesOnTimeout {
+		return false
+	}
+	if this.ForwardTimeout != that1.ForwardTimeout {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ChannelOverrides) > 0 {
+		for iNdEx := len(m.ChannelOverrides) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelOverrides[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPfmparams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ForwardTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardTimeout):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPfmparams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x12
+	if m.RetriesOnTimeout != 0 {
+		i = encodeVarintPfmparams(dAtA, i, uint64(m.RetriesOnTimeout))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ChannelOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.ForwardTimeout, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardTimeout):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintPfmparams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1a
+	if m.RetriesOnTimeout != 0 {
+		i = encodeVarintPfmparams(dAtA, i, uint64(m.RetriesOnTimeout))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintPfmparams(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPfmparams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPfmparams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.RetriesOnTimeout != 0 {
+		n += 1 + sovPfmparams(uint64(m.RetriesOnTimeout))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardTimeout)
+	n += 1 + l + sovPfmparams(uint64(l))
+	if len(m.ChannelOverrides) > 0 {
+		for _, e := range m.ChannelOverrides {
+			l = e.Size()
+			n += 1 + l + sovPfmparams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ChannelOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovPfmparams(uint64(l))
+	}
+	if m.RetriesOnTimeout != 0 {
+		n += 1 + sovPfmparams(uint64(m.RetriesOnTimeout))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.ForwardTimeout)
+	n += 1 + l + sovPfmparams(uint64(l))
+	return n
+}
+
+func sovPfmparams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPfmparams(x uint64) (n int) {
+	return sovPfmparams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfmparams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesOnTimeout", wireType)
+			}
+			m.RetriesOnTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesOnTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ForwardTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelOverrides", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelOverrides = append(m.ChannelOverrides, ChannelOverride{})
+			if err := m.ChannelOverrides[len(m.ChannelOverrides)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfmparams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ChannelOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPfmparams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ChannelOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ChannelOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetriesOnTimeout", wireType)
+			}
+			m.RetriesOnTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetriesOnTimeout |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ForwardTimeout", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPfmparams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.ForwardTimeout, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPfmparams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPfmparams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPfmparams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPfmparams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPfmparams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPfmparams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPfmparams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPfmparams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPfmparams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPfmparams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPfmparams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPfmparams = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,6 @@
+// Package ratelimit extends the rate limits of the IBC rate limiting module,
+// configured per denom and channel, beyond the transfer stack: to the packets
+// of other applications carrying ICS-20 data, such as wasm contracts, and to
+// the value moved off the chain from interchain accounts by ICS-27 host
+// transactions, other than that already counted by the transfer stack.
+package ratelimit
@@ -0,0 +1,36 @@
+package ratelimit
+
+import (
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+)
+
+// RateLimitKeeper checks and updates the flows of the rate limits.
+type RateLimitKeeper interface {
+	porttypes.ICS4Wrapper
+	ReceiveRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error
+	AcknowledgeRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) error
+	TimeoutRateLimitedPacket(ctx sdk.Context, packet channeltypes.Packet) error
+	CheckRateLimitAndUpdateFlow(ctx sdk.Context, direction ratelimittypes.PacketDirection, packetInfo ratelimitkeeper.RateLimitedPacketInfo) (bool, error)
+	GetAllRateLimits(ctx sdk.Context) []ratelimittypes.RateLimit
+}
+
+// ICAHostKeeper provides the interchain account addresses of the ICS-27 host.
+type ICAHostKeeper interface {
+	GetInterchainAccountAddress(ctx sdk.Context, connectionID, portID string) (string, bool)
+}
+
+// ChannelKeeper provides the connection of the host channels.
+type ChannelKeeper interface {
+	GetChannel(ctx sdk.Context, portID, channelID string) (channeltypes.Channel, bool)
+}
+
+// TransferKeeper provides the amounts escrowed by the transfer module.
+type TransferKeeper interface {
+	GetAllTotalEscrowed(ctx sdk.Context) sdk.Coins
+}
@@ -0,0 +1,254 @@
+package ratelimit
+
+import (
+	"fmt"
+
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/cosmos/gaia/v29/x/icahostv2"
+)
+
+// outflowTracker derives the value the host transactions move off the chain
+// from the interchain accounts.
+//
+// Only the coins leaving the chain count: the increase of their transfer
+// escrow plus the vouchers burned by the transfer module, during the
+// transaction. Delegations, sends to local accounts, other burns and other
+// transfers within the chain are not counted. The outflow already recorded
+// by the rate limits of the transfer stack during the transaction, such as
+// that of the ICS-20 transfers of the account on a rate-limited channel, is
+// deducted, so that the coins are counted once. The outflow of a denom is
+// bounded by the amount the account spent, including coins it received
+// during the transaction.
+type outflowTracker struct {
+	keeper         RateLimitKeeper
+	transferKeeper TransferKeeper
+}
+
+// outflowSnapshot holds the transfer escrow and the outflow recorded by the
+// rate limits, per denom, before a host transaction.
+type outflowSnapshot struct {
+	escrow  sdk.Coins
+	limited map[string]sdkmath.Int
+}
+
+// snapshot returns the outflowSnapshot of the current state.
+func (t outflowTracker) snapshot(ctx sdk.Context) outflowSnapshot {
+	limited := make(map[string]sdkmath.Int)
+	for _, rateLimit := range t.keeper.GetAllRateLimits(ctx) {
+		if rateLimit.Path == nil || rateLimit.Flow == nil || rateLimit.Flow.Outflow.IsNil() {
+			continue
+		}
+		outflow, found := limited[rateLimit.Path.Denom]
+		if !found {
+			outflow = sdkmath.ZeroInt()
+		}
+		limited[rateLimit.Path.Denom] = outflow.Add(rateLimit.Flow.Outflow)
+	}
+	return outflowSnapshot{
+		escrow:  t.transferKeeper.GetAllTotalEscrowed(ctx),
+		limited: limited,
+	}
+}
+
+// limitedOutflow returns the outflow of denom recorded by the rate limits.
+func (s outflowSnapshot) limitedOutflow(denom string) sdkmath.Int {
+	if outflow, found := s.limited[denom]; found {
+		return outflow
+	}
+	return sdkmath.ZeroInt()
+}
+
+// spentAndBurned returns the coins spent by account, and the coins burned by
+// the transfer module, in events.
+func spentAndBurned(events sdk.Events, account sdk.AccAddress) (spent, burned sdk.Coins) {
+	spender, burner := account.String(), authtypes.NewModuleAddress(transfertypes.ModuleName).String()
+	for _, event := range events {
+		var key, address string
+		switch event.Type {
+		case banktypes.EventTypeCoinSpent:
+			key, address = banktypes.AttributeKeySpender, spender
+		case banktypes.EventTypeCoinBurn:
+			key, address = banktypes.AttributeKeyBurner, burner
+		default:
+			continue
+		}
+		attribute, found := event.GetAttribute(key)
+		if !found || attribute.Value != address {
+			continue
+		}
+		amount, found := event.GetAttribute(sdk.AttributeKeyAmount)
+		if !found {
+			continue
+		}
+		coins, err := sdk.ParseCoinsNormalized(amount.Value)
+		if err != nil {
+			continue
+		}
+		if event.Type == banktypes.EventTypeCoinSpent {
+			spent = spent.Add(coins...)
+		} else {
+			burned = burned.Add(coins...)
+		}
+	}
+	return spent, burned
+}
+
+// addAccountOutflow adds the value moved off the chain from the interchain
+// account by a host transaction, given the outflowSnapshot before it and the
+// events it emitted, to the outflow of the rate limits of the channel, or IBC
+// v2 client, the transaction was received on, and fails if it exceeds their
+// quota.
+func (t outflowTracker) addAccountOutflow(ctx sdk.Context, channelID string, account sdk.AccAddress, before outflowSnapshot, events sdk.Events) error {
+	spent, burned := spentAndBurned(events, account)
+	if spent.IsZero() {
+		return nil
+	}
+	after := t.snapshot(ctx)
+	for _, coin := range spent {
+		offChain := after.escrow.AmountOf(coin.Denom).Sub(before.escrow.AmountOf(coin.Denom)).
+			Add(burned.AmountOf(coin.Denom)).
+			Sub(after.limitedOutflow(coin.Denom).Sub(before.limitedOutflow(coin.Denom)))
+		amount := sdkmath.MinInt(coin.Amount, offChain)
+		if !amount.IsPositive() {
+			continue
+		}
+		_, err := t.keeper.CheckRateLimitAndUpdateFlow(ctx, ratelimittypes.PACKET_SEND, ratelimitkeeper.RateLimitedPacketInfo{
+			ChannelID: channelID,
+			Denom:     coin.Denom,
+			Amount:    amount,
+			Sender:    account.String(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+var _ porttypes.IBCModule = ICAHostMiddleware{}
+
+// ICAHostMiddleware applies the rate limits of the host channels to the
+// value moved off the chain from the interchain accounts by the host
+// transactions, as described by outflowTracker: it is added to the outflow of
+// the channel, and the transaction is rejected with an error acknowledgement
+// if it exceeds the quota.
+type ICAHostMiddleware struct {
+	porttypes.IBCModule
+	outflowTracker
+	hostKeeper    ICAHostKeeper
+	channelKeeper ChannelKeeper
+}
+
+// NewICAHostMiddleware returns a new ICAHostMiddleware wrapping the ICS-27
+// host stack app.
+func NewICAHostMiddleware(
+	app porttypes.IBCModule,
+	k RateLimitKeeper,
+	hostKeeper ICAHostKeeper,
+	channelKeeper ChannelKeeper,
+	transferKeeper TransferKeeper,
+) ICAHostMiddleware {
+	return ICAHostMiddleware{
+		IBCModule: app,
+		outflowTracker: outflowTracker{
+			keeper:         k,
+			transferKeeper: transferKeeper,
+		},
+		hostKeeper:    hostKeeper,
+		channelKeeper: channelKeeper,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im ICAHostMiddleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	channel, found := im.channelKeeper.GetChannel(ctx, packet.DestinationPort, packet.DestinationChannel)
+	if !found || len(channel.ConnectionHops) == 0 {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	address, found := im.hostKeeper.GetInterchainAccountAddress(ctx, channel.ConnectionHops[0], packet.SourcePort)
+	if !found {
+		return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+	}
+	account, err := sdk.AccAddressFromBech32(address)
+	if err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	before := im.snapshot(ctx)
+	hostCtx := ctx.WithEventManager(sdk.NewEventManager())
+	ack := im.IBCModule.OnRecvPacket(hostCtx, channelVersion, packet, relayer)
+	ctx.EventManager().EmitEvents(hostCtx.EventManager().Events())
+	// the state changes of failed packets are discarded
+	if ack == nil || !ack.Success() {
+		return ack
+	}
+	if err := im.addAccountOutflow(ctx, packet.DestinationChannel, account, before, hostCtx.EventManager().Events()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("interchain account transaction was denied: %s", err))
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+	return ack
+}
+
+var _ api.IBCModule = ICAHostMiddlewareV2{}
+
+// ICAHostMiddlewareV2 is the ICAHostMiddleware of the IBC v2 host stack,
+// applying the rate limits of the host clients.
+type ICAHostMiddlewareV2 struct {
+	api.IBCModule
+	outflowTracker
+}
+
+// NewICAHostMiddlewareV2 returns a new ICAHostMiddlewareV2 wrapping the IBC
+// v2 host stack app.
+func NewICAHostMiddlewareV2(app api.IBCModule, k RateLimitKeeper, transferKeeper TransferKeeper) ICAHostMiddlewareV2 {
+	return ICAHostMiddlewareV2{
+		IBCModule: app,
+		outflowTracker: outflowTracker{
+			keeper:         k,
+			transferKeeper: transferKeeper,
+		},
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im ICAHostMiddlewareV2) OnRecvPacket(
+	ctx sdk.Context,
+	sourceClient string,
+	destinationClient string,
+	sequence uint64,
+	payload channeltypesv2.Payload,
+	relayer sdk.AccAddress,
+) channeltypesv2.RecvPacketResult {
+	account := icahostv2.AccountAddress(destinationClient, payload.SourcePort)
+
+	before := im.snapshot(ctx)
+	hostCtx := ctx.WithEventManager(sdk.NewEventManager())
+	res := im.IBCModule.OnRecvPacket(hostCtx, sourceClient, destinationClient, sequence, payload, relayer)
+	ctx.EventManager().EmitEvents(hostCtx.EventManager().Events())
+	// the state changes of failed packets are discarded
+	if res.Status != channeltypesv2.PacketStatus_Success {
+		return res
+	}
+	if err := im.addAccountOutflow(ctx, destinationClient, account, before, hostCtx.EventManager().Events()); err != nil {
+		ctx.Logger().Error(fmt.Sprintf("interchain account transaction was denied: %s", err))
+		return channeltypesv2.RecvPacketResult{
+			Status: channeltypesv2.PacketStatus_Failure,
+		}
+	}
+	return res
+}
@@ -0,0 +1,108 @@
+package ratelimit
+
+import (
+	"encoding/json"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+)
+
+// IsICS20Packet returns true if the packet data is a valid JSON encoded
+// ICS-20 FungibleTokenPacketData, whatever the port of the packet.
+func IsICS20Packet(data []byte) bool {
+	var packetData transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(data, &packetData); err != nil {
+		return false
+	}
+	return packetData.ValidateBasic() == nil
+}
+
+var _ porttypes.IBCModule = ICS20Middleware{}
+
+// ICS20Middleware applies the rate limits of the channels to the packets
+// carrying ICS-20 data of an application other than transfer, e.g. the
+// packets of wasm contracts: received packets are rejected if they exceed
+// the quota, and the outflow of sent packets is undone if they fail. The
+// other packets are passed to the application unchanged.
+type ICS20Middleware struct {
+	porttypes.IBCModule
+	keeper RateLimitKeeper
+}
+
+// NewICS20Middleware returns a new ICS20Middleware wrapping app.
+func NewICS20Middleware(app porttypes.IBCModule, k RateLimitKeeper) ICS20Middleware {
+	return ICS20Middleware{
+		IBCModule: app,
+		keeper:    k,
+	}
+}
+
+// OnRecvPacket implements the IBCModule interface.
+func (im ICS20Middleware) OnRecvPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) exported.Acknowledgement {
+	if IsICS20Packet(packet.GetData()) {
+		if err := im.keeper.ReceiveRateLimitedPacket(ctx, packet); err != nil {
+			ctx.Logger().Error("ICS-20 packet receive was denied", "port", packet.DestinationPort, "channel", packet.DestinationChannel, "error", err)
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+	return im.IBCModule.OnRecvPacket(ctx, channelVersion, packet, relayer)
+}
+
+// OnAcknowledgementPacket implements the IBCModule interface.
+func (im ICS20Middleware) OnAcknowledgementPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, acknowledgement []byte, relayer sdk.AccAddress) error {
+	if IsICS20Packet(packet.GetData()) {
+		if err := im.keeper.AcknowledgeRateLimitedPacket(ctx, packet, acknowledgement); err != nil {
+			return err
+		}
+	}
+	return im.IBCModule.OnAcknowledgementPacket(ctx, channelVersion, packet, acknowledgement, relayer)
+}
+
+// OnTimeoutPacket implements the IBCModule interface.
+func (im ICS20Middleware) OnTimeoutPacket(ctx sdk.Context, channelVersion string, packet channeltypes.Packet, relayer sdk.AccAddress) error {
+	if IsICS20Packet(packet.GetData()) {
+		if err := im.keeper.TimeoutRateLimitedPacket(ctx, packet); err != nil {
+			return err
+		}
+	}
+	return im.IBCModule.OnTimeoutPacket(ctx, channelVersion, packet, relayer)
+}
+
+var _ porttypes.ICS4Wrapper = ICS20ICS4Wrapper{}
+
+// ICS20ICS4Wrapper sends the packets carrying ICS-20 data through the rate
+// limit keeper, which adds their amount to the outflow of the channel and
+// rejects them if it exceeds the quota, and the other packets through the
+// wrapped ICS4Wrapper. The rate limit keeper must wrap the same ICS4Wrapper.
+type ICS20ICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+	keeper RateLimitKeeper
+}
+
+// NewICS20ICS4Wrapper returns a new ICS20ICS4Wrapper.
+func NewICS20ICS4Wrapper(ics4Wrapper porttypes.ICS4Wrapper, k RateLimitKeeper) ICS20ICS4Wrapper {
+	return ICS20ICS4Wrapper{
+		ICS4Wrapper: ics4Wrapper,
+		keeper:      k,
+	}
+}
+
+// SendPacket implements the ICS4Wrapper interface.
+func (w ICS20ICS4Wrapper) SendPacket(
+	ctx sdk.Context,
+	sourcePort string,
+	sourceChannel string,
+	timeoutHeight clienttypes.Height,
+	timeoutTimestamp uint64,
+	data []byte,
+) (uint64, error) {
+	if IsICS20Packet(data) {
+		return w.keeper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	}
+	return w.ICS4Wrapper.SendPacket(ctx, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+}
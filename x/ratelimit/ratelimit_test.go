@@ -0,0 +1,394 @@
+package ratelimit_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/cosmos/gogoproto/proto"
+	ratelimitkeeper "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/keeper"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+	stakingkeeper "github.com/cosmos/cosmos-sdk/x/staking/keeper"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+
+	icahosttypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/host/types"
+	icatypes "github.com/cosmos/ibc-go/v10/modules/apps/27-interchain-accounts/types"
+	transfertypes "github.com/cosmos/ibc-go/v10/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v10/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v10/modules/core/04-channel/types"
+	channeltypesv2 "github.com/cosmos/ibc-go/v10/modules/core/04-channel/v2/types"
+	porttypes "github.com/cosmos/ibc-go/v10/modules/core/05-port/types"
+	"github.com/cosmos/ibc-go/v10/modules/core/api"
+	"github.com/cosmos/ibc-go/v10/modules/core/exported"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/icahostv2"
+	gaiaratelimit "github.com/cosmos/gaia/v29/x/ratelimit"
+)
+
+var errQuotaExceeded = errors.New("quota exceeded")
+
+// rateLimitKeeper records the calls of the middlewares, and fails them with
+// err if set.
+type rateLimitKeeper struct {
+	calls      []string
+	flows      []ratelimitkeeper.RateLimitedPacketInfo
+	rateLimits []ratelimittypes.RateLimit
+	err        error
+}
+
+func (k *rateLimitKeeper) SendPacket(sdk.Context, string, string, clienttypes.Height, uint64, []byte) (uint64, error) {
+	k.calls = append(k.calls, "SendPacket")
+	return 1, k.err
+}
+
+func (*rateLimitKeeper) WriteAcknowledgement(sdk.Context, exported.PacketI, exported.Acknowledgement) error {
+	return nil
+}
+
+func (*rateLimitKeeper) GetAppVersion(sdk.Context, string, string) (string, bool) { return "", false }
+
+func (k *rateLimitKeeper) ReceiveRateLimitedPacket(sdk.Context, channeltypes.Packet) error {
+	k.calls = append(k.calls, "ReceiveRateLimitedPacket")
+	return k.err
+}
+
+func (k *rateLimitKeeper) AcknowledgeRateLimitedPacket(sdk.Context, channeltypes.Packet, []byte) error {
+	k.calls = append(k.calls, "AcknowledgeRateLimitedPacket")
+	return k.err
+}
+
+func (k *rateLimitKeeper) TimeoutRateLimitedPacket(sdk.Context, channeltypes.Packet) error {
+	k.calls = append(k.calls, "TimeoutRateLimitedPacket")
+	return k.err
+}
+
+func (k *rateLimitKeeper) CheckRateLimitAndUpdateFlow(_ sdk.Context, direction ratelimittypes.PacketDirection, packetInfo ratelimitkeeper.RateLimitedPacketInfo) (bool, error) {
+	if direction != ratelimittypes.PACKET_SEND {
+		panic("unexpected direction")
+	}
+	k.flows = append(k.flows, packetInfo)
+	return true, k.err
+}
+
+func (k *rateLimitKeeper) GetAllRateLimits(sdk.Context) []ratelimittypes.RateLimit {
+	return k.rateLimits
+}
+
+// app is an IBC application and ICS4Wrapper recording its calls, and running
+// recv on received packets if set.
+type app struct {
+	porttypes.IBCModule
+	porttypes.ICS4Wrapper
+	calls []string
+	recv  func(ctx sdk.Context) error
+}
+
+func (a *app) OnRecvPacket(ctx sdk.Context, _ string, _ channeltypes.Packet, _ sdk.AccAddress) exported.Acknowledgement {
+	a.calls = append(a.calls, "OnRecvPacket")
+	if a.recv != nil {
+		if err := a.recv(ctx); err != nil {
+			return channeltypes.NewErrorAcknowledgement(err)
+		}
+	}
+	return channeltypes.NewResultAcknowledgement([]byte{1})
+}
+
+func (a *app) OnAcknowledgementPacket(sdk.Context, string, channeltypes.Packet, []byte, sdk.AccAddress) error {
+	a.calls = append(a.calls, "OnAcknowledgementPacket")
+	return nil
+}
+
+func (a *app) OnTimeoutPacket(sdk.Context, string, channeltypes.Packet, sdk.AccAddress) error {
+	a.calls = append(a.calls, "OnTimeoutPacket")
+	return nil
+}
+
+func (a *app) SendPacket(sdk.Context, string, string, clienttypes.Height, uint64, []byte) (uint64, error) {
+	a.calls = append(a.calls, "SendPacket")
+	return 2, nil
+}
+
+var ics20Data = transfertypes.NewFungibleTokenPacketData("uatom", "100", "cosmos1sender", "cosmos1receiver", "").GetBytes()
+
+func TestIsICS20Packet(t *testing.T) {
+	require.True(t, gaiaratelimit.IsICS20Packet(ics20Data))
+	require.True(t, gaiaratelimit.IsICS20Packet([]byte(`{"denom":"uatom","amount":"1","sender":"a","receiver":"b","memo":"{}"}`)))
+	require.False(t, gaiaratelimit.IsICS20Packet([]byte(`{"denom":"uatom","amount":"0","sender":"a","receiver":"b"}`)))
+	require.False(t, gaiaratelimit.IsICS20Packet([]byte(`{"execute":{"msg":"hello"}}`)))
+	require.False(t, gaiaratelimit.IsICS20Packet([]byte{0x0a, 0x01}))
+}
+
+func TestICS20Middleware(t *testing.T) {
+	ctx := sdk.Context{}.WithLogger(helpers.Setup(t).Logger())
+	ics20Packet := channeltypes.Packet{SourcePort: "wasm.cosmos1contract", DestinationChannel: "channel-0", Data: ics20Data}
+	otherPacket := channeltypes.Packet{SourcePort: "wasm.cosmos1contract", DestinationChannel: "channel-0", Data: []byte(`{"ping":{}}`)}
+
+	k, a := &rateLimitKeeper{}, &app{}
+	middleware := gaiaratelimit.NewICS20Middleware(a, k)
+	require.True(t, middleware.OnRecvPacket(ctx, "", ics20Packet, nil).Success())
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, "", ics20Packet, nil, nil))
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, "", ics20Packet, nil))
+	require.Equal(t, []string{"ReceiveRateLimitedPacket", "AcknowledgeRateLimitedPacket", "TimeoutRateLimitedPacket"}, k.calls)
+	require.Equal(t, []string{"OnRecvPacket", "OnAcknowledgementPacket", "OnTimeoutPacket"}, a.calls)
+
+	// other packets are not rate limited
+	k, a = &rateLimitKeeper{err: errQuotaExceeded}, &app{}
+	middleware = gaiaratelimit.NewICS20Middleware(a, k)
+	require.True(t, middleware.OnRecvPacket(ctx, "", otherPacket, nil).Success())
+	require.NoError(t, middleware.OnAcknowledgementPacket(ctx, "", otherPacket, nil, nil))
+	require.NoError(t, middleware.OnTimeoutPacket(ctx, "", otherPacket, nil))
+	require.Empty(t, k.calls)
+	require.Equal(t, []string{"OnRecvPacket", "OnAcknowledgementPacket", "OnTimeoutPacket"}, a.calls)
+
+	// ICS-20 packets exceeding the quota are rejected
+	require.False(t, middleware.OnRecvPacket(ctx, "", ics20Packet, nil).Success())
+	require.ErrorIs(t, middleware.OnTimeoutPacket(ctx, "", ics20Packet, nil), errQuotaExceeded)
+	require.Equal(t, []string{"ReceiveRateLimitedPacket", "TimeoutRateLimitedPacket"}, k.calls)
+	require.Len(t, a.calls, 3)
+
+	wrapper := gaiaratelimit.NewICS20ICS4Wrapper(a, k)
+	_, err := wrapper.SendPacket(ctx, "wasm.cosmos1contract", "channel-0", clienttypes.Height{}, 1, ics20Data)
+	require.ErrorIs(t, err, errQuotaExceeded)
+	sequence, err := wrapper.SendPacket(ctx, "wasm.cosmos1contract", "channel-0", clienttypes.Height{}, 1, otherPacket.Data)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), sequence)
+	require.Equal(t, []string{"ReceiveRateLimitedPacket", "TimeoutRateLimitedPacket", "SendPacket"}, k.calls)
+	require.Equal(t, "SendPacket", a.calls[len(a.calls)-1])
+}
+
+// hostKeeper returns the interchain account of any port.
+type hostKeeper struct {
+	address string
+}
+
+func (k hostKeeper) GetInterchainAccountAddress(sdk.Context, string, string) (string, bool) {
+	return k.address, k.address != ""
+}
+
+// channelKeeper returns the channels on connection-0.
+type channelKeeper struct{}
+
+func (channelKeeper) GetChannel(sdk.Context, string, string) (channeltypes.Channel, bool) {
+	return channeltypes.Channel{ConnectionHops: []string{"connection-0"}}, true
+}
+
+func TestICAHostMiddleware(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+
+	account := sdk.AccAddress("interchain_account__")
+	bob := sdk.AccAddress("bob_________________")
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000), sdk.NewInt64Coin("uosmo", 1_000), sdk.NewInt64Coin(sdk.DefaultBondDenom, 1_000))
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds.Add(funds...)))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, funds))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, bob, funds))
+
+	// escrow simulates an ICS-20 transfer of coins from the account on
+	// channel-0
+	escrow := func(ctx sdk.Context, coin sdk.Coin) error {
+		if err := gaiaApp.BankKeeper.SendCoins(ctx, account, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0"), sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+		total := gaiaApp.TransferKeeper.GetTotalEscrowForDenom(ctx, coin.Denom)
+		gaiaApp.TransferKeeper.SetTotalEscrowForDenom(ctx, total.Add(coin))
+		return nil
+	}
+	// burn burns coins of the account by the module
+	burn := func(ctx sdk.Context, module string, coin sdk.Coin) error {
+		coins := sdk.NewCoins(coin)
+		if err := gaiaApp.BankKeeper.SendCoinsFromAccountToModule(ctx, account, module, coins); err != nil {
+			return err
+		}
+		return gaiaApp.BankKeeper.BurnCoins(ctx, module, coins)
+	}
+
+	packet := channeltypes.Packet{SourcePort: "icacontroller-owner", DestinationChannel: "channel-3"}
+	a := &app{}
+	k := &rateLimitKeeper{}
+	middleware := gaiaratelimit.NewICAHostMiddleware(a, k, hostKeeper{account.String()}, channelKeeper{}, gaiaApp.TransferKeeper)
+
+	// delegations, sends to local accounts and burns other than those of
+	// the transfer module do not leave the chain
+	a.recv = func(ctx sdk.Context) error {
+		if err := gaiaApp.BankKeeper.SendCoins(ctx, account, bob, sdk.NewCoins(sdk.NewInt64Coin("uatom", 300))); err != nil {
+			return err
+		}
+		if err := burn(ctx, govtypes.ModuleName, sdk.NewInt64Coin("uosmo", 100)); err != nil {
+			return err
+		}
+		validators, err := gaiaApp.StakingKeeper.GetAllValidators(ctx)
+		if err != nil {
+			return err
+		}
+		_, err = stakingkeeper.NewMsgServerImpl(gaiaApp.StakingKeeper).Delegate(ctx,
+			stakingtypes.NewMsgDelegate(account.String(), validators[0].OperatorAddress, sdk.NewInt64Coin(sdk.DefaultBondDenom, 500)))
+		return err
+	}
+	require.True(t, middleware.OnRecvPacket(ctx, "", packet, nil).Success())
+	require.Empty(t, k.flows)
+
+	// escrowed coins and vouchers burned by the transfer module leave the
+	// chain, bounded by the amount spent by the account
+	a.recv = func(ctx sdk.Context) error {
+		if err := escrow(ctx, sdk.NewInt64Coin("uatom", 200)); err != nil {
+			return err
+		}
+		return burn(ctx, transfertypes.ModuleName, sdk.NewInt64Coin("uosmo", 100))
+	}
+	require.True(t, middleware.OnRecvPacket(ctx, "", packet, nil).Success())
+	require.Equal(t, []ratelimitkeeper.RateLimitedPacketInfo{{
+		ChannelID: "channel-3",
+		Denom:     "uatom",
+		Amount:    sdkmath.NewInt(200),
+		Sender:    account.String(),
+	}, {
+		ChannelID: "channel-3",
+		Denom:     "uosmo",
+		Amount:    sdkmath.NewInt(100),
+		Sender:    account.String(),
+	}}, k.flows)
+
+	// coins received during the transaction and then transferred, including
+	// those of a denom the account did not hold, leave the chain
+	k.flows = nil
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, sdk.NewCoins(sdk.NewInt64Coin("uion", 50))))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, bob, sdk.NewCoins(sdk.NewInt64Coin("uion", 50))))
+	a.recv = func(ctx sdk.Context) error {
+		received := sdk.NewCoins(sdk.NewInt64Coin("uatom", 400), sdk.NewInt64Coin("uion", 50))
+		if err := gaiaApp.BankKeeper.SendCoins(ctx, bob, account, received); err != nil {
+			return err
+		}
+		for _, coin := range received {
+			if err := escrow(ctx, coin); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	require.True(t, middleware.OnRecvPacket(ctx, "", packet, nil).Success())
+	require.Equal(t, []ratelimitkeeper.RateLimitedPacketInfo{{
+		ChannelID: "channel-3",
+		Denom:     "uatom",
+		Amount:    sdkmath.NewInt(400),
+		Sender:    account.String(),
+	}, {
+		ChannelID: "channel-3",
+		Denom:     "uion",
+		Amount:    sdkmath.NewInt(50),
+		Sender:    account.String(),
+	}}, k.flows)
+
+	// the outflow counted by the rate limits of the transfer stack is not
+	// counted again
+	k.flows = nil
+	k.rateLimits = []ratelimittypes.RateLimit{{
+		Path: &ratelimittypes.Path{Denom: "uatom", ChannelOrClientId: "channel-0"},
+		Flow: &ratelimittypes.Flow{Outflow: sdkmath.ZeroInt()},
+	}}
+	a.recv = func(ctx sdk.Context) error {
+		k.rateLimits[0].Flow.Outflow = k.rateLimits[0].Flow.Outflow.AddRaw(100)
+		return escrow(ctx, sdk.NewInt64Coin("uatom", 100))
+	}
+	require.True(t, middleware.OnRecvPacket(ctx, "", packet, nil).Success())
+	require.Empty(t, k.flows)
+
+	// failed transactions are not rate limited
+	a.recv = func(sdk.Context) error { return errors.New("failed") }
+	require.False(t, middleware.OnRecvPacket(ctx, "", packet, nil).Success())
+	require.Empty(t, k.flows)
+
+	k.err = errQuotaExceeded
+	a.recv = func(ctx sdk.Context) error {
+		return escrow(ctx, sdk.NewInt64Coin("uosmo", 10))
+	}
+	require.False(t, middleware.OnRecvPacket(ctx, "", packet, nil).Success())
+	require.Len(t, k.flows, 1)
+
+	// packets of unregistered accounts are passed to the host
+	k.flows = nil
+	middleware = gaiaratelimit.NewICAHostMiddleware(a, k, hostKeeper{}, channelKeeper{}, gaiaApp.TransferKeeper)
+	require.True(t, middleware.OnRecvPacket(ctx, "", packet, nil).Success())
+	require.Empty(t, k.flows)
+}
+
+func TestICAHostMiddlewareV2(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	cdc := gaiaApp.AppCodec()
+
+	const clientID, portID = "07-tendermint-0", "wasm2cosmos1contract"
+	account := icahostv2.AccountAddress(clientID, portID)
+	bob := sdk.AccAddress("bob_________________")
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000))
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, account, funds))
+
+	k := &rateLimitKeeper{}
+	middleware := gaiaratelimit.NewICAHostMiddlewareV2(
+		icahostv2.NewIBCModule(cdc, gaiaApp.ICAHostKeeper, gaiaApp.AccountKeeper, gaiaApp.MsgServiceRouter()),
+		k, gaiaApp.TransferKeeper,
+	)
+	recv := func(amount int64) channeltypesv2.RecvPacketResult {
+		bz, err := icatypes.SerializeCosmosTx(cdc, []proto.Message{
+			banktypes.NewMsgSend(account, bob, sdk.NewCoins(sdk.NewInt64Coin("uatom", amount))),
+		}, icatypes.EncodingProtobuf)
+		require.NoError(t, err)
+		data := icatypes.InterchainAccountPacketData{Type: icatypes.EXECUTE_TX, Data: bz}
+		return middleware.OnRecvPacket(ctx, "07-tendermint-5", clientID, 1, channeltypesv2.Payload{
+			SourcePort:      portID,
+			DestinationPort: icahosttypes.SubModuleName,
+			Version:         icatypes.Version,
+			Encoding:        icatypes.EncodingProtobuf,
+			Value:           data.GetBytes(),
+		}, nil)
+	}
+
+	// sends to local accounts do not leave the chain
+	require.Equal(t, channeltypesv2.PacketStatus_Success, recv(400).Status)
+	require.Empty(t, k.flows)
+
+	a := &appV2{recv: func(ctx sdk.Context) error {
+		coin := sdk.NewInt64Coin("uatom", 100)
+		if err := gaiaApp.BankKeeper.SendCoins(ctx, account, transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0"), sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+		gaiaApp.TransferKeeper.SetTotalEscrowForDenom(ctx, gaiaApp.TransferKeeper.GetTotalEscrowForDenom(ctx, "uatom").Add(coin))
+		return nil
+	}}
+	middleware = gaiaratelimit.NewICAHostMiddlewareV2(a, k, gaiaApp.TransferKeeper)
+	payload := channeltypesv2.Payload{SourcePort: portID}
+	require.Equal(t, channeltypesv2.PacketStatus_Success, middleware.OnRecvPacket(ctx, "07-tendermint-5", clientID, 1, payload, nil).Status)
+	require.Equal(t, []ratelimitkeeper.RateLimitedPacketInfo{{
+		ChannelID: clientID,
+		Denom:     "uatom",
+		Amount:    sdkmath.NewInt(100),
+		Sender:    account.String(),
+	}}, k.flows)
+
+	k.err = errQuotaExceeded
+	require.Equal(t, channeltypesv2.PacketStatus_Failure, middleware.OnRecvPacket(ctx, "07-tendermint-5", clientID, 2, payload, nil).Status)
+	require.Len(t, k.flows, 2)
+}
+
+// appV2 is an IBC v2 application running recv on received packets.
+type appV2 struct {
+	api.IBCModule
+	recv func(ctx sdk.Context) error
+}
+
+func (a *appV2) OnRecvPacket(ctx sdk.Context, _, _ string, _ uint64, _ channeltypesv2.Payload, _ sdk.AccAddress) channeltypesv2.RecvPacketResult {
+	if err := a.recv(ctx); err != nil {
+		return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Failure}
+	}
+	return channeltypesv2.RecvPacketResult{Status: channeltypesv2.PacketStatus_Success}
+}
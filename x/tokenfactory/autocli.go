@@ -0,0 +1,47 @@
+package tokenfactory
+
+import (
+	"fmt"
+
+	autocliv1 "cosmossdk.io/api/cosmos/autocli/v1"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/version"
+
+	"github.com/cosmos/gaia/v29/x/tokenfactory/types"
+)
+
+func (am ExtensionModule) AutoCLIOptions() *autocliv1.ModuleOptions {
+	return &autocliv1.ModuleOptions{
+		Query: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Query_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "Params",
+					Use:       "params",
+					Short:     "Query the denom creation fee increment and the denom quota",
+					Example:   fmt.Sprintf("$ %s query gaiatokenfactory params", version.AppName),
+				},
+				{
+					RpcMethod: "DenomCreationFee",
+					Use:       "denom-creation-fee [creator]",
+					Short:     "Query the fee an account is charged for creating its next denom",
+					Example: fmt.Sprintf("$ %s query gaiatokenfactory denom-creation-fee %s1gghjut3ccd8ay0zduzj64hwre2fxs9ldmqhffj",
+						version.AppName, sdk.GetConfig().GetBech32AccountAddrPrefix()),
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{
+						{ProtoField: "creator"},
+					},
+				},
+			},
+		},
+		Tx: &autocliv1.ServiceCommandDescriptor{
+			Service: types.Msg_serviceDesc.ServiceName,
+			RpcCommandOptions: []*autocliv1.RpcCommandOptions{
+				{
+					RpcMethod: "UpdateParams",
+					Skip:      true, // skipped because authority gated
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/cosmos/gaia/v29/x/tokenfactory/types"
+)
+
+// DenomCount returns the number of denoms created by creator.
+func (k Keeper) DenomCount(ctx context.Context, creator string) uint64 {
+	return uint64(len(k.tokenFactoryKeeper.GetDenomsFromCreator(ctx, creator)))
+}
+
+// DenomCreationFee returns the full fee charged to creator for its next
+// denom: the tokenfactory denom creation fee plus the surcharge for the
+// denoms it already has.
+func (k Keeper) DenomCreationFee(ctx context.Context, creator string) (sdk.Coins, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fee := k.tokenFactoryKeeper.GetParams(ctx).DenomCreationFee
+	return fee.Add(params.DenomCreationFeeSurcharge(k.DenomCount(ctx, creator))...), nil
+}
+
+// ChargeDenomCreation fails if creator has reached the denom quota, and
+// otherwise sends the denom creation fee surcharge for the denoms it already
+// has from creator to the community pool. The tokenfactory denom creation
+// fee is charged by the tokenfactory keeper.
+func (k Keeper) ChargeDenomCreation(ctx context.Context, creator string) error {
+	creatorAddr, err := sdk.AccAddressFromBech32(creator)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid creator address: %s", err)
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return err
+	}
+
+	denoms := k.DenomCount(ctx, creator)
+	if params.DenomQuotaEnabled() && denoms >= params.MaxDenomsPerCreator {
+		return errorsmod.Wrapf(types.ErrDenomQuotaExceeded,
+			"%s has %d denoms, max %d", creator, denoms, params.MaxDenomsPerCreator)
+	}
+
+	surcharge := params.DenomCreationFeeSurcharge(denoms)
+	if surcharge.IsZero() {
+		return nil
+	}
+	return k.distrKeeper.FundCommunityPool(ctx, surcharge, creatorAddr)
+}
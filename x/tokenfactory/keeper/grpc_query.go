@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/tokenfactory/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries the parameters of the Gaia tokenfactory extensions
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// DenomCreationFee queries the fee an account is charged for creating its next denom
+func (k Querier) DenomCreationFee(ctx context.Context, req *types.QueryDenomCreationFeeRequest) (*types.QueryDenomCreationFeeResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if _, err := sdk.AccAddressFromBech32(req.Creator); err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	fee, err := k.Keeper.DenomCreationFee(ctx, req.Creator)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryDenomCreationFeeResponse{
+		Fee:       fee,
+		Denoms:    k.DenomCount(ctx, req.Creator),
+		MaxDenoms: params.MaxDenomsPerCreator,
+	}, nil
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/tokenfactory/types"
+)
+
+// Keeper of the x/gaiatokenfactory store
+type Keeper struct {
+	storeService       storetypes.KVStoreService
+	cdc                codec.BinaryCodec
+	tokenFactoryKeeper types.TokenFactoryKeeper
+	distrKeeper        types.DistributionKeeper
+	authority          string
+}
+
+// NewKeeper creates a new gaiatokenfactory Keeper instance
+func NewKeeper(
+	cdc codec.BinaryCodec,
+	storeService storetypes.KVStoreService,
+	tokenFactoryKeeper types.TokenFactoryKeeper,
+	distrKeeper types.DistributionKeeper,
+	authority string,
+) *Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+
+	return &Keeper{
+		storeService:       storeService,
+		cdc:                cdc,
+		tokenFactoryKeeper: tokenFactoryKeeper,
+		distrKeeper:        distrKeeper,
+		authority:          authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the x/gaiatokenfactory module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
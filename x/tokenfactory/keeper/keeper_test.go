@@ -0,0 +1,99 @@
+package keeper_test
+
+import (
+	"testing"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	minttypes "github.com/cosmos/cosmos-sdk/x/mint/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/tokenfactory/keeper"
+	"github.com/cosmos/gaia/v29/x/tokenfactory/types"
+)
+
+func TestDenomCreation(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	k := gaiaApp.GaiaTokenFactoryKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	querier := keeper.NewQuerier(k)
+	authority := k.GetAuthority()
+
+	creator := sdk.AccAddress([]byte("denom_creator_______"))
+	funds := sdk.NewCoins(sdk.NewInt64Coin("uatom", 10_000))
+	require.NoError(t, gaiaApp.BankKeeper.MintCoins(ctx, minttypes.ModuleName, funds))
+	require.NoError(t, gaiaApp.BankKeeper.SendCoinsFromModuleToAccount(ctx, minttypes.ModuleName, creator, funds))
+	require.NoError(t, gaiaApp.TokenFactoryKeeper.SetParams(ctx, tokenfactorytypes.Params{
+		DenomCreationFee: sdk.NewCoins(sdk.NewInt64Coin("uatom", 1_000)),
+	}))
+
+	// the state changes of failed messages are discarded, as in a transaction
+	createDenom := func(subdenom string) error {
+		msg := tokenfactorytypes.NewMsgCreateDenom(creator.String(), subdenom)
+		cacheCtx, write := ctx.CacheContext()
+		if _, err := gaiaApp.MsgServiceRouter().Handler(msg)(cacheCtx, msg); err != nil {
+			return err
+		}
+		write()
+		return nil
+	}
+	queryFee := func() *types.QueryDenomCreationFeeResponse {
+		resp, err := querier.DenomCreationFee(ctx, &types.QueryDenomCreationFeeRequest{Creator: creator.String()})
+		require.NoError(t, err)
+		return resp
+	}
+	communityPool := func() sdk.Coins {
+		feePool, err := gaiaApp.DistrKeeper.FeePool.Get(ctx)
+		require.NoError(t, err)
+		coins, _ := feePool.CommunityPool.TruncateDecimal()
+		return coins
+	}
+	balance := func() int64 {
+		return gaiaApp.BankKeeper.GetBalance(ctx, creator, "uatom").Amount.Int64()
+	}
+
+	// only the tokenfactory fee is charged by default
+	uatom := func(amount int64) sdk.Coins { return sdk.NewCoins(sdk.NewInt64Coin("uatom", amount)) }
+	require.Equal(t, &types.QueryDenomCreationFeeResponse{Fee: uatom(1_000)}, queryFee())
+	require.NoError(t, createDenom("first"))
+	require.Equal(t, int64(9_000), balance())
+	require.Equal(t, &types.QueryDenomCreationFeeResponse{Fee: uatom(1_000), Denoms: 1}, queryFee())
+
+	params := types.NewParams(uatom(500), 3)
+	_, err := msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress("other").String(), Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams(sdk.Coins{sdk.Coin{Denom: "uatom", Amount: sdkmath.NewInt(-1)}}, 3),
+	})
+	require.ErrorContains(t, err, "invalid denom creation fee increment")
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	// the surcharge grows with the denoms of the creator and is sent to the
+	// community pool
+	require.Equal(t, &types.QueryDenomCreationFeeResponse{Fee: uatom(1_500), Denoms: 1, MaxDenoms: 3}, queryFee())
+	pool := communityPool()
+	require.NoError(t, createDenom("second"))
+	require.Equal(t, int64(7_500), balance())
+	require.Equal(t, pool.Add(uatom(1_500)...), communityPool())
+	require.Equal(t, &types.QueryDenomCreationFeeResponse{Fee: uatom(2_000), Denoms: 2, MaxDenoms: 3}, queryFee())
+
+	require.ErrorIs(t, createDenom("second"), tokenfactorytypes.ErrDenomExists)
+	require.NoError(t, createDenom("third"))
+	require.Equal(t, int64(5_500), balance())
+
+	require.ErrorIs(t, createDenom("fourth"), types.ErrDenomQuotaExceeded)
+	require.Equal(t, int64(5_500), balance())
+	require.Len(t, gaiaApp.TokenFactoryKeeper.GetDenomsFromCreator(ctx, creator.String()), 3)
+
+	require.Equal(t, types.NewGenesisState(params), k.ExportGenesis(ctx))
+}
@@ -0,0 +1,50 @@
+package tokenfactory
+
+import (
+	"encoding/json"
+
+	bindingstypes "github.com/cosmos/tokenfactory/x/tokenfactory/bindings/types"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// CustomMessageDecorator returns a wasm message handler decorator enforcing
+// the denom quota and charging the denom creation fee surcharge on the
+// create_denom messages of contracts, which the tokenfactory bindings
+// execute without going through the message router. It must wrap the
+// tokenfactory message handler decorator.
+func CustomMessageDecorator(gaiaKeeper GaiaTokenFactoryKeeper) func(wasmkeeper.Messenger) wasmkeeper.Messenger {
+	return func(old wasmkeeper.Messenger) wasmkeeper.Messenger {
+		return &CustomMessenger{
+			wrapped:    old,
+			gaiaKeeper: gaiaKeeper,
+		}
+	}
+}
+
+// CustomMessenger charges the denom creations of contracts before passing
+// their messages to the wrapped messenger.
+type CustomMessenger struct {
+	wrapped    wasmkeeper.Messenger
+	gaiaKeeper GaiaTokenFactoryKeeper
+}
+
+var _ wasmkeeper.Messenger = (*CustomMessenger)(nil)
+
+// DispatchMsg implements wasmkeeper.Messenger.
+func (m *CustomMessenger) DispatchMsg(ctx sdk.Context, contractAddr sdk.AccAddress, contractIBCPortID string, msg wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	if msg.Custom != nil {
+		// malformed messages are rejected by the tokenfactory bindings
+		var contractMsg bindingstypes.TokenFactoryMsg
+		if err := json.Unmarshal(msg.Custom, &contractMsg); err == nil && contractMsg.CreateDenom != nil {
+			if err := m.gaiaKeeper.ChargeDenomCreation(ctx, contractAddr.String()); err != nil {
+				return nil, nil, nil, err
+			}
+		}
+	}
+	return m.wrapped.DispatchMsg(ctx, contractAddr, contractIBCPortID, msg)
+}
@@ -0,0 +1,66 @@
+package tokenfactory_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	gaiatokenfactory "github.com/cosmos/gaia/v29/x/tokenfactory"
+)
+
+// MockMessenger records the number of dispatched messages
+type MockMessenger struct {
+	dispatched int
+}
+
+func (m *MockMessenger) DispatchMsg(sdk.Context, sdk.AccAddress, string, wasmvmtypes.CosmosMsg) ([]sdk.Event, [][]byte, [][]*codectypes.Any, error) {
+	m.dispatched++
+	return nil, nil, nil, nil
+}
+
+// MockGaiaTokenFactoryKeeper records the charged creators, and fails the
+// charges with err if set
+type MockGaiaTokenFactoryKeeper struct {
+	charged []string
+	err     error
+}
+
+func (m *MockGaiaTokenFactoryKeeper) ChargeDenomCreation(_ context.Context, creator string) error {
+	m.charged = append(m.charged, creator)
+	return m.err
+}
+
+func TestCustomMessageDecorator(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+	createDenom := wasmvmtypes.CosmosMsg{Custom: []byte(`{"create_denom":{"subdenom":"token"}}`)}
+
+	wrapped := &MockMessenger{}
+	gaiaKeeper := &MockGaiaTokenFactoryKeeper{}
+	messenger := gaiatokenfactory.CustomMessageDecorator(gaiaKeeper)(wrapped)
+
+	for _, msg := range []wasmvmtypes.CosmosMsg{
+		createDenom,
+		{Custom: []byte(`{"mint_tokens":{"denom":"factory/contract/token","amount":"1","mint_to_address":"cosmos1"}}`)},
+		{Custom: []byte(`not JSON`)},
+		{Bank: &wasmvmtypes.BankMsg{Send: &wasmvmtypes.SendMsg{ToAddress: "cosmos1", Amount: wasmvmtypes.Array[wasmvmtypes.Coin]{}}}},
+	} {
+		_, _, _, err := messenger.DispatchMsg(sdk.Context{}, contract, "", msg)
+		require.NoError(t, err)
+	}
+	// only the denom creations are charged
+	require.Equal(t, []string{contract.String()}, gaiaKeeper.charged)
+	require.Equal(t, 4, wrapped.dispatched)
+
+	// denied creations are not dispatched
+	gaiaKeeper.err = errors.New("denom quota exceeded")
+	_, _, _, err := messenger.DispatchMsg(sdk.Context{}, contract, "", createDenom)
+	require.ErrorIs(t, err, gaiaKeeper.err)
+	require.Equal(t, 4, wrapped.dispatched)
+}
@@ -0,0 +1,182 @@
+// Package tokenfactory provides a custom wrapper around the tokenfactory
+// module that enforces a per-creator denom quota and charges a denom
+// creation fee surcharge, growing with the number of denoms the creator
+// already has, at the MsgServer level. The surcharge is sent to the
+// community pool, on top of the tokenfactory denom creation fee. The wasm
+// message handler decorator applies the same controls to the denoms created
+// by contracts through the tokenfactory bindings.
+//
+// The quota and the fee increment are governance controlled parameters
+// owned by the gaiatokenfactory ExtensionModule, which is registered
+// alongside the wrapped tokenfactory module.
+package tokenfactory
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/cosmos/tokenfactory/x/tokenfactory"
+	tokenfactorykeeper "github.com/cosmos/tokenfactory/x/tokenfactory/keeper"
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+	gwruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
+
+	"cosmossdk.io/core/appmodule"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/codec"
+	cdctypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	paramstypes "github.com/cosmos/cosmos-sdk/x/params/types"
+
+	"github.com/cosmos/gaia/v29/x/tokenfactory/keeper"
+	"github.com/cosmos/gaia/v29/x/tokenfactory/types"
+)
+
+const (
+	consensusVersion uint64 = 1
+)
+
+var (
+	_ module.AppModuleBasic = ExtensionModuleBasic{}
+	_ module.HasServices    = ExtensionModule{}
+	_ module.HasGenesis     = ExtensionModule{}
+
+	_ appmodule.AppModule = ExtensionModule{}
+)
+
+// AppModule wraps the standard tokenfactory module to intercept RegisterServices
+type AppModule struct {
+	tokenfactory.AppModule
+	keeper     tokenfactorykeeper.Keeper
+	gaiaKeeper *keeper.Keeper
+
+	// legacySubspace is used solely for migration of x/params managed parameters
+	legacySubspace paramstypes.Subspace
+}
+
+// NewAppModule creates a new AppModule object that wraps the tokenfactory
+// module with the denom quota and the denom creation fee surcharge.
+func NewAppModule(
+	keeper tokenfactorykeeper.Keeper,
+	gaiaKeeper *keeper.Keeper,
+	ak tokenfactorytypes.AccountKeeper,
+	bk tokenfactorytypes.BankKeeper,
+	ss paramstypes.Subspace,
+) AppModule {
+	return AppModule{
+		AppModule:      tokenfactory.NewAppModule(keeper, ak, bk, ss),
+		keeper:         keeper,
+		gaiaKeeper:     gaiaKeeper,
+		legacySubspace: ss,
+	}
+}
+
+// RegisterServices overrides the standard tokenfactory module's RegisterServices to register our custom MsgServer
+func (am AppModule) RegisterServices(cfg module.Configurator) {
+	// Register the QueryServer normally (delegating to the keeper)
+	tokenfactorytypes.RegisterQueryServer(cfg.QueryServer(), am.keeper)
+
+	// Create the standard MsgServer implementation from the keeper
+	standardMsgServer := tokenfactorykeeper.NewMsgServerImpl(am.keeper)
+
+	// Wrap the standard MsgServer with our custom logic
+	wrappedMsgServer := NewMsgServerWrapper(standardMsgServer, am.gaiaKeeper)
+
+	// Register our wrapped MsgServer
+	tokenfactorytypes.RegisterMsgServer(cfg.MsgServer(), wrappedMsgServer)
+
+	m := tokenfactorykeeper.NewMigrator(am.keeper, am.legacySubspace)
+	if err := cfg.RegisterMigration(tokenfactorytypes.ModuleName, 1, m.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate x/%s from version 1 to 2: %v", tokenfactorytypes.ModuleName, err))
+	}
+}
+
+// ExtensionModuleBasic defines the basic application module of the Gaia
+// tokenfactory extensions.
+type ExtensionModuleBasic struct{}
+
+// Name returns the Gaia tokenfactory extensions' module name.
+func (ExtensionModuleBasic) Name() string {
+	return types.ModuleName
+}
+
+// RegisterLegacyAminoCodec registers the Gaia tokenfactory extensions' types on the given LegacyAmino codec.
+func (ExtensionModuleBasic) RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
+	types.RegisterLegacyAminoCodec(cdc)
+}
+
+// RegisterInterfaces registers the module's interface types
+func (ExtensionModuleBasic) RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
+	types.RegisterInterfaces(registry)
+}
+
+// DefaultGenesis returns default genesis state as raw bytes for the Gaia
+// tokenfactory extensions.
+func (ExtensionModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(types.DefaultGenesisState())
+}
+
+// ValidateGenesis performs genesis state validation for the Gaia tokenfactory extensions.
+func (ExtensionModuleBasic) ValidateGenesis(cdc codec.JSONCodec, config client.TxEncodingConfig, bz json.RawMessage) error {
+	var data types.GenesisState
+	if err := cdc.UnmarshalJSON(bz, &data); err != nil {
+		return fmt.Errorf("failed to unmarshal %s genesis state: %w", types.ModuleName, err)
+	}
+
+	return types.ValidateGenesis(&data)
+}
+
+// RegisterGRPCGatewayRoutes registers the gRPC Gateway routes for the Gaia tokenfactory extensions.
+func (ExtensionModuleBasic) RegisterGRPCGatewayRoutes(clientCtx client.Context, mux *gwruntime.ServeMux) {
+	if err := types.RegisterQueryHandlerClient(context.Background(), mux, types.NewQueryClient(clientCtx)); err != nil {
+		panic(err)
+	}
+}
+
+// ExtensionModule holds the Gaia specific tokenfactory state, i.e. the denom
+// quota and the denom creation fee increment, under its own module name and
+// store.
+type ExtensionModule struct {
+	ExtensionModuleBasic
+
+	keeper *keeper.Keeper
+}
+
+// NewExtensionModule creates a new ExtensionModule object
+func NewExtensionModule(keeper *keeper.Keeper) ExtensionModule {
+	return ExtensionModule{
+		keeper: keeper,
+	}
+}
+
+// IsOnePerModuleType implements the depinject.OnePerModuleType interface.
+func (am ExtensionModule) IsOnePerModuleType() {}
+
+// IsAppModule implements the appmodule.AppModule interface.
+func (am ExtensionModule) IsAppModule() {}
+
+// RegisterServices registers module services.
+func (am ExtensionModule) RegisterServices(cfg module.Configurator) {
+	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
+	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQuerier(am.keeper))
+}
+
+// InitGenesis performs genesis initialization for the Gaia tokenfactory extensions.
+func (am ExtensionModule) InitGenesis(ctx sdk.Context, cdc codec.JSONCodec, data json.RawMessage) {
+	var genesisState types.GenesisState
+
+	cdc.MustUnmarshalJSON(data, &genesisState)
+
+	am.keeper.InitGenesis(ctx, &genesisState)
+}
+
+// ExportGenesis returns the exported genesis state as raw bytes for the Gaia
+// tokenfactory extensions.
+func (am ExtensionModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.RawMessage {
+	return cdc.MustMarshalJSON(am.keeper.ExportGenesis(ctx))
+}
+
+// ConsensusVersion implements AppModule/ConsensusVersion.
+func (ExtensionModule) ConsensusVersion() uint64 { return consensusVersion }
@@ -0,0 +1,38 @@
+package tokenfactory
+
+import (
+	"context"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+)
+
+// GaiaTokenFactoryKeeper enforces the governance controlled denom quota and
+// charges the denom creation fee surcharge
+type GaiaTokenFactoryKeeper interface {
+	ChargeDenomCreation(ctx context.Context, creator string) error
+}
+
+// MsgServerWrapper wraps the standard tokenfactory MsgServer
+type MsgServerWrapper struct {
+	tokenfactorytypes.MsgServer
+	gaiaKeeper GaiaTokenFactoryKeeper
+}
+
+// NewMsgServerWrapper creates a new MsgServer wrapper
+func NewMsgServerWrapper(keeper tokenfactorytypes.MsgServer, gaiaKeeper GaiaTokenFactoryKeeper) MsgServerWrapper {
+	return MsgServerWrapper{
+		MsgServer:  keeper,
+		gaiaKeeper: gaiaKeeper,
+	}
+}
+
+// CreateDenom enforces the denom quota of the sender and charges the denom
+// creation fee surcharge on MsgCreateDenom
+func (s MsgServerWrapper) CreateDenom(goCtx context.Context, msg *tokenfactorytypes.MsgCreateDenom) (*tokenfactorytypes.MsgCreateDenomResponse, error) {
+	if err := s.gaiaKeeper.ChargeDenomCreation(goCtx, msg.Sender); err != nil {
+		return nil, err
+	}
+
+	// Forward to the original implementation
+	return s.MsgServer.CreateDenom(goCtx, msg)
+}
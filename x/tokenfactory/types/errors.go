@@ -0,0 +1,8 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/gaiatokenfactory module sentinel errors
+var (
+	ErrDenomQuotaExceeded = errors.Register(ModuleName, 2, "denom quota exceeded")
+)
@@ -0,0 +1,20 @@
+package types
+
+import (
+	"context"
+
+	tokenfactorytypes "github.com/cosmos/tokenfactory/x/tokenfactory/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// TokenFactoryKeeper defines the expected interface of the tokenfactory keeper.
+type TokenFactoryKeeper interface {
+	GetParams(ctx context.Context) tokenfactorytypes.Params
+	GetDenomsFromCreator(ctx context.Context, creator string) []string
+}
+
+// DistributionKeeper defines the expected interface of the distribution keeper.
+type DistributionKeeper interface {
+	FundCommunityPool(ctx context.Context, amount sdk.Coins, sender sdk.AccAddress) error
+}
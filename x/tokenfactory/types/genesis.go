@@ -0,0 +1,17 @@
+package types
+
+func NewGenesisState(params Params) *GenesisState {
+	return &GenesisState{
+		Params: params,
+	}
+}
+
+func DefaultGenesisState() *GenesisState {
+	return &GenesisState{
+		Params: DefaultParams(),
+	}
+}
+
+func ValidateGenesis(gs *GenesisState) error {
+	return gs.Params.Validate()
+}
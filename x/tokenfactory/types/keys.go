@@ -0,0 +1,16 @@
+package types
+
+const (
+	// ModuleName is the name of the Gaia tokenfactory extensions module. It
+	// differs from the tokenfactory module name, which is kept by the wrapped
+	// tokenfactory module.
+	ModuleName = "gaiatokenfactory"
+
+	// StoreKey is the string store representation
+	StoreKey = ModuleName
+
+	// RouterKey is the msg router key for the Gaia tokenfactory extensions
+	RouterKey = ModuleName
+)
+
+var ParamsKey = []byte{0x01} // key for the parameters of the Gaia tokenfactory extensions
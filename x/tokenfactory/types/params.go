@@ -0,0 +1,52 @@
+package types
+
+import (
+	"fmt"
+
+	sdkmath "cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+const (
+	// DefaultMaxDenomsPerCreator does not limit the denoms of an account
+	DefaultMaxDenomsPerCreator uint64 = 0
+)
+
+// NewParams creates a new Params instance
+func NewParams(denomCreationFeeIncrement sdk.Coins, maxDenomsPerCreator uint64) Params {
+	return Params{
+		DenomCreationFeeIncrement: denomCreationFeeIncrement,
+		MaxDenomsPerCreator:       maxDenomsPerCreator,
+	}
+}
+
+// DefaultParams returns a default set of parameters: only the tokenfactory
+// denom creation fee is charged, and accounts may create any number of
+// denoms.
+func DefaultParams() Params {
+	return NewParams(sdk.NewCoins(), DefaultMaxDenomsPerCreator)
+}
+
+// validate a set of params
+func (p Params) Validate() error {
+	if err := p.DenomCreationFeeIncrement.Validate(); err != nil {
+		return fmt.Errorf("invalid denom creation fee increment: %w", err)
+	}
+	return nil
+}
+
+// DenomQuotaEnabled returns true if the number of denoms of an account is
+// limited.
+func (p Params) DenomQuotaEnabled() bool {
+	return p.MaxDenomsPerCreator > 0
+}
+
+// DenomCreationFeeSurcharge returns the fee charged on top of the
+// tokenfactory denom creation fee to a creator having denoms denoms.
+func (p Params) DenomCreationFeeSurcharge(denoms uint64) sdk.Coins {
+	if denoms == 0 {
+		return sdk.NewCoins()
+	}
+	return p.DenomCreationFeeIncrement.MulInt(sdkmath.NewIntFromUint64(denoms))
+}
@@ -0,0 +1,415 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gaia/tokenfactory/v1beta1/tokenfactory.proto
+
+package types
+
+import (
+	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the parameters for the Gaia tokenfactory extensions.
+type Params struct {
+	// denom_creation_fee_increment is the fee charged for creating a denom
+	// for each denom its creator already has, on top of the tokenfactory
+	// denom_creation_fee param. It is sent to the community pool.
+	DenomCreationFeeIncrement github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,1,rep,name=denom_creation_fee_increment,json=denomCreationFeeIncrement,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"denom_creation_fee_increment"`
+	// max_denoms_per_creator is the maximum number of denoms an account may
+	// create. Zero means unlimited.
+	MaxDenomsPerCreator uint64 `protobuf:"varint,2,opt,name=max_denoms_per_creator,json=maxDenomsPerCreator,proto3" json:"max_denoms_per_creator,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_67c15ac4ccca55a3, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func (m *Params) GetDenomCreationFeeIncrement() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.DenomCreationFeeIncrement
+	}
+	return nil
+}
+
+func (m *Params) GetMaxDenomsPerCreator() uint64 {
+	if m != nil {
+		return m.MaxDenomsPerCreator
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "gaia.tokenfactory.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("gaia/tokenfactory/v1beta1/tokenfactory.proto", fileDescriptor_67c15ac4ccca55a3)
+}
+
+var fileDescriptor_67c15ac4ccca55a3 = []byte{
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x50, 0xb1, 0x4e, 0x02, 0x41,
+	0x10, 0xbd, 0x55, 0x43, 0x71, 0x56, 0xa2, 0x31, 0x40, 0xcc, 0x42, 0x6c, 0x24, 0xa8, 0xb7, 0x41,
+	0x62, 0x63, 0x09, 0x6a, 0x62, 0x47, 0x28, 0x6d, 0x2e, 0x7b, 0xc7, 0x70, 0x5e, 0xc8, 0xee, 0x90,
+	0xdd, 0xd5, 0xc0, 0x2f, 0x58, 0xe9, 0x1f, 0x58, 0x1a, 0x2b, 0x3e, 0x83, 0x92, 0xd2, 0x4a, 0x0d,
+	0x14, 0xf8, 0x0d, 0x56, 0xe6, 0xf6, 0x0e, 0x93, 0x8b, 0xcd, 0xce, 0x66, 0xde, 0x9b, 0x79, 0xf3,
+	0x9e, 0x7b, 0x12, 0xf1, 0x98, 0x33, 0x83, 0x43, 0x90, 0x03, 0x1e, 0x1a, 0x54, 0x13, 0xf6, 0xd0,
+	0x0c, 0xc0, 0xf0, 0x66, 0xae, 0xe9, 0x8d, 0x14, 0x1a, 0x2c, 0x96, 0x13, 0xb6, 0x97, 0x03, 0x32,
+	0x76, 0x65, 0x2f, 0xc2, 0x08, 0x2d, 0x8b, 0x25, 0xbf, 0x74, 0xa0, 0x42, 0x43, 0xd4, 0x02, 0x35,
+	0x0b, 0xb8, 0x86, 0xbf, 0xc5, 0x21, 0xc6, 0x32, 0xc3, 0x77, 0xb8, 0x88, 0x25, 0x32, 0xfb, 0xa6,
+	0xad, 0xc3, 0x1f, 0xe2, 0x16, 0xba, 0x5c, 0x71, 0xa1, 0x8b, 0xcf, 0xc4, 0x3d, 0xe8, 0x83, 0x44,
+	0xe1, 0x87, 0x0a, 0xb8, 0x89, 0x51, 0xfa, 0x03, 0x00, 0x3f, 0x96, 0xa1, 0x02, 0x01, 0xd2, 0x94,
+	0x48, 0x6d, 0xb3, 0xbe, 0x7d, 0x56, 0xf6, 0x52, 0x15, 0x2f, 0x51, 0x59, 0x1f, 0xe4, 0x75, 0x30,
+	0x96, 0xed, 0xf3, 0xd9, 0x47, 0xd5, 0x79, 0xfb, 0xac, 0xd6, 0xa3, 0xd8, 0xdc, 0xdd, 0x07, 0x5e,
+	0x88, 0x82, 0x65, 0x27, 0xa5, 0xe5, 0x54, 0xf7, 0x87, 0xcc, 0x4c, 0x46, 0xa0, 0xed, 0x80, 0x7e,
+	0x5d, 0x4d, 0x1b, 0xa4, 0x57, 0xb6, 0xaa, 0x9d, 0x4c, 0xf4, 0x1a, 0xe0, 0x66, 0x2d, 0x59, 0x6c,
+	0xb9, 0xfb, 0x82, 0x8f, 0x7d, 0x4b, 0xd0, 0xfe, 0x08, 0x54, 0x7a, 0x1b, 0xaa, 0xd2, 0x46, 0x8d,
+	0xd4, 0xb7, 0x7a, 0xbb, 0x82, 0x8f, 0x2f, 0x2d, 0xd8, 0x05, 0xd5, 0x49, 0xa1, 0x8b, 0xa3, 0xef,
+	0x97, 0x2a, 0x79, 0x5c, 0x4d, 0x1b, 0xd4, 0xc6, 0x3d, 0x66, 0x49, 0xc9, 0x85, 0x9e, 0x3a, 0x6e,
+	0x5f, 0xcd, 0x16, 0x94, 0xcc, 0x17, 0x94, 0x7c, 0x2d, 0x28, 0x79, 0x5a, 0x52, 0x67, 0xbe, 0xa4,
+	0xce, 0xfb, 0x92, 0x3a, 0xb7, 0xc7, 0xff, 0x1d, 0x64, 0xbb, 0x72, 0x7b, 0xac, 0x95, 0xa0, 0x60,
+	0xa3, 0x6c, 0xfd, 0x0e, 0x00, 0x32, 0x3c, 0xe7, 0x00, 0xde, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.DenomCreationFeeIncrement) != len(that1.DenomCreationFeeIncrement) {
+		return false
+	}
+	for i := range this.DenomCreationFeeIncrement {
+		if !this.DenomCreationFeeIncrement[i].Equal(&that1.DenomCreationFeeIncrement[i]) {
+			return false
+		}
+	}
+	if this.MaxDenomsPerCreator != that1.MaxDenomsPerCreator {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxDenomsPerCreator != 0 {
+		i = encodeVarintTokenfactory(dAtA, i, uint64(m.MaxDenomsPerCreator))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.DenomCreationFeeIncrement) > 0 {
+		for iNdEx := len(m.DenomCreationFeeIncrement) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DenomCreationFeeIncrement[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTokenfactory(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTokenfactory(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTokenfactory(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.DenomCreationFeeIncrement) > 0 {
+		for _, e := range m.DenomCreationFeeIncrement {
+			l = e.Size()
+			n += 1 + l + sovTokenfactory(uint64(l))
+		}
+	}
+	if m.MaxDenomsPerCreator != 0 {
+		n += 1 + sovTokenfactory(uint64(m.MaxDenomsPerCreator))
+	}
+	return n
+}
+
+func sovTokenfactory(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTokenfactory(x uint64) (n int) {
+	return sovTokenfactory(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomCreationFeeIncrement", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DenomCreationFeeIncrement = append(m.DenomCreationFeeIncrement, types.Coin{})
+			if err := m.DenomCreationFeeIncrement[len(m.DenomCreationFeeIncrement)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxDenomsPerCreator", wireType)
+			}
+			m.MaxDenomsPerCreator = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxDenomsPerCreator |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTokenfactory(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTokenfactory
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipTokenfactory(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowTokenfactory
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowTokenfactory
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthTokenfactory
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupTokenfactory
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthTokenfactory
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthTokenfactory        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowTokenfactory          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupTokenfactory = fmt.Errorf("proto: unexpected end of group")
+)
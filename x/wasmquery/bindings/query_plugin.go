@@ -0,0 +1,222 @@
+package bindings
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"strings"
+
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	feemarkettypes "github.com/skip-mev/feemarket/x/feemarket/types"
+
+	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+)
+
+// FeeMarketKeeper provides the feemarket params, state and gas prices.
+type FeeMarketKeeper interface {
+	GetParams(ctx sdk.Context) (feemarkettypes.Params, error)
+	GetState(ctx sdk.Context) (feemarkettypes.State, error)
+	GetMinGasPrices(ctx sdk.Context) (sdk.DecCoins, error)
+}
+
+// RateLimitKeeper provides the rate limits of the IBC channels.
+type RateLimitKeeper interface {
+	GetRateLimit(ctx sdk.Context, denom string, channelOrClientID string) (ratelimittypes.RateLimit, bool)
+}
+
+// MaxInFlightPacketsLimit is the maximum number of in-flight packets returned
+// by a query, and the number returned if the query sets no limit.
+const MaxInFlightPacketsLimit = query.DefaultLimit
+
+// QueryPlugin answers the Gaia custom queries.
+type QueryPlugin struct {
+	cdc             codec.BinaryCodec
+	feeMarketKeeper FeeMarketKeeper
+	rateLimitKeeper RateLimitKeeper
+	// pfmStoreService is the store of the packet forward middleware, whose
+	// keeper only exports all the in-flight packets at once
+	pfmStoreService corestore.KVStoreService
+}
+
+// NewQueryPlugin returns a reference to a new QueryPlugin.
+func NewQueryPlugin(
+	cdc codec.BinaryCodec,
+	feeMarketKeeper FeeMarketKeeper,
+	rateLimitKeeper RateLimitKeeper,
+	pfmStoreService corestore.KVStoreService,
+) *QueryPlugin {
+	return &QueryPlugin{
+		cdc:             cdc,
+		feeMarketKeeper: feeMarketKeeper,
+		rateLimitKeeper: rateLimitKeeper,
+		pfmStoreService: pfmStoreService,
+	}
+}
+
+// CustomQuerier dispatches the Gaia custom queries, and passes the other
+// custom queries to next, if set.
+func CustomQuerier(qp *QueryPlugin, next wasmkeeper.CustomQuerier) wasmkeeper.CustomQuerier {
+	return func(ctx sdk.Context, request json.RawMessage) ([]byte, error) {
+		var contractQuery GaiaQuery
+		if err := json.Unmarshal(request, &contractQuery); err != nil {
+			return nil, errorsmod.Wrap(err, "gaia query")
+		}
+
+		var (
+			res any
+			err error
+		)
+		switch {
+		case contractQuery.FeeMarket != nil:
+			res, err = qp.GetFeeMarket(ctx)
+		case contractQuery.RateLimit != nil:
+			res, err = qp.GetRateLimit(ctx, contractQuery.RateLimit.Denom, contractQuery.RateLimit.ChannelID)
+		case contractQuery.InFlightPackets != nil:
+			res, err = qp.GetInFlightPackets(ctx, contractQuery.InFlightPackets)
+		case next != nil:
+			return next(ctx, request)
+		default:
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: "unknown gaia query variant"}
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		bz, err := json.Marshal(res)
+		if err != nil {
+			return nil, fmt.Errorf("failed to JSON marshal %T: %w", res, err)
+		}
+		return bz, nil
+	}
+}
+
+// GetFeeMarket returns the feemarket params and state, and the current gas
+// prices in each accepted fee denom.
+func (qp QueryPlugin) GetFeeMarket(ctx sdk.Context) (*FeeMarketResponse, error) {
+	params, err := qp.feeMarketKeeper.GetParams(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "fee market params")
+	}
+	state, err := qp.feeMarketKeeper.GetState(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "fee market state")
+	}
+	gasPrices, err := qp.feeMarketKeeper.GetMinGasPrices(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "fee market gas prices")
+	}
+
+	res := &FeeMarketResponse{
+		Enabled:         params.Enabled,
+		FeeDenom:        params.FeeDenom,
+		BaseGasPrice:    state.BaseGasPrice.String(),
+		MinBaseGasPrice: params.MinBaseGasPrice.String(),
+		LearningRate:    state.LearningRate.String(),
+		GasPrices:       make([]DecCoin, 0, len(gasPrices)),
+	}
+	for _, gasPrice := range gasPrices {
+		res.GasPrices = append(res.GasPrices, DecCoin{Denom: gasPrice.Denom, Amount: gasPrice.Amount.String()})
+	}
+	return res, nil
+}
+
+// GetRateLimit returns the rate limit of denom on the channel, with the
+// amounts that may still be sent and received before its quota is exceeded.
+func (qp QueryPlugin) GetRateLimit(ctx sdk.Context, denom, channelID string) (*RateLimitResponse, error) {
+	rateLimit, found := qp.rateLimitKeeper.GetRateLimit(ctx, denom, channelID)
+	if !found {
+		return &RateLimitResponse{}, nil
+	}
+
+	quota, flow := rateLimit.Quota, rateLimit.Flow
+	info := &RateLimitInfo{
+		MaxPercentSend: quota.MaxPercentSend.String(),
+		MaxPercentRecv: quota.MaxPercentRecv.String(),
+		DurationHours:  quota.DurationHours,
+		Inflow:         flow.Inflow.String(),
+		Outflow:        flow.Outflow.String(),
+		ChannelValue:   flow.ChannelValue.String(),
+	}
+	// The rate limiting middleware rejects a packet once the net flow in its
+	// direction exceeds the quota percentage of the channel value, and does not
+	// enforce quotas while the channel value is zero.
+	if !flow.ChannelValue.IsZero() {
+		info.RemainingSend = remainingCapacity(flow.ChannelValue, quota.MaxPercentSend, flow.Outflow.Sub(flow.Inflow))
+		info.RemainingRecv = remainingCapacity(flow.ChannelValue, quota.MaxPercentRecv, flow.Inflow.Sub(flow.Outflow))
+	}
+	return &RateLimitResponse{RateLimit: info}, nil
+}
+
+// remainingCapacity returns the amount that may be added to the net flow
+// before it exceeds maxPercent of the channel value.
+func remainingCapacity(channelValue, maxPercent, netFlow sdkmath.Int) *string {
+	threshold := channelValue.Mul(maxPercent).QuoRaw(100)
+	remaining := sdkmath.MaxInt(threshold.Sub(netFlow), sdkmath.ZeroInt()).String()
+	return &remaining
+}
+
+// GetInFlightPackets returns a page of the packets forwarded by PFM and
+// awaiting an acknowledgement or a timeout, in the order of their store keys:
+// by channel, port, and sequence compared as a string. If the request sets a
+// channel, only the store prefix of this channel is iterated.
+func (qp QueryPlugin) GetInFlightPackets(ctx sdk.Context, req *InFlightPackets) (*InFlightPacketsResponse, error) {
+	// in-flight packets are keyed by "<channel>/<port>/<sequence>" of the
+	// forwarded packet
+	var keyPrefix []byte
+	if req.ChannelID != "" {
+		keyPrefix = []byte(req.ChannelID + "/")
+	}
+	limit := req.Limit
+	if limit == 0 || limit > MaxInFlightPacketsLimit {
+		limit = MaxInFlightPacketsLimit
+	}
+
+	store := prefix.NewStore(runtime.KVStoreAdapter(qp.pfmStoreService.OpenKVStore(ctx)), keyPrefix)
+	res := &InFlightPacketsResponse{Packets: []InFlightPacket{}}
+	pageRes, err := query.Paginate(store, &query.PageRequest{Key: req.Key, Limit: limit}, func(key, value []byte) error {
+		fullKey := string(keyPrefix) + string(key)
+		parts := strings.Split(fullKey, "/")
+		if len(parts) != 3 {
+			return fmt.Errorf("invalid in-flight packet key %q", fullKey)
+		}
+		sequence, err := strconv.ParseUint(parts[2], 10, 64)
+		if err != nil {
+			return fmt.Errorf("invalid in-flight packet key %q: %w", fullKey, err)
+		}
+		var packet pfmtypes.InFlightPacket
+		if err := qp.cdc.Unmarshal(value, &packet); err != nil {
+			return err
+		}
+		res.Packets = append(res.Packets, InFlightPacket{
+			ChannelID:             parts[0],
+			PortID:                parts[1],
+			Sequence:              sequence,
+			RefundChannelID:       packet.RefundChannelId,
+			RefundPortID:          packet.RefundPortId,
+			RefundSequence:        packet.RefundSequence,
+			OriginalSenderAddress: packet.OriginalSenderAddress,
+			PacketData:            packet.PacketData,
+			RetriesRemaining:      packet.RetriesRemaining,
+			Timeout:               packet.Timeout,
+			Nonrefundable:         packet.Nonrefundable,
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	res.NextKey = pageRes.NextKey
+	return res, nil
+}
@@ -0,0 +1,163 @@
+package bindings_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	pfmtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v10/packetforward/types"
+	ratelimittypes "github.com/cosmos/ibc-apps/modules/rate-limiting/v10/types"
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdkmath "cosmossdk.io/math"
+
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/wasmquery/bindings"
+)
+
+// rateLimitKeeper returns the rate limits by denom and channel.
+type rateLimitKeeper map[[2]string]ratelimittypes.RateLimit
+
+func (k rateLimitKeeper) GetRateLimit(_ sdk.Context, denom, channelID string) (ratelimittypes.RateLimit, bool) {
+	rateLimit, found := k[[2]string{denom, channelID}]
+	return rateLimit, found
+}
+
+func TestCustomQuerier(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+
+	rateLimits := rateLimitKeeper{
+		{"uatom", "channel-0"}: {
+			Quota: &ratelimittypes.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(20), DurationHours: 24},
+			Flow:  &ratelimittypes.Flow{Inflow: sdkmath.NewInt(30), Outflow: sdkmath.NewInt(120), ChannelValue: sdkmath.NewInt(1_000)},
+		},
+		{"uatom", "channel-1"}: {
+			Quota: &ratelimittypes.Quota{MaxPercentSend: sdkmath.NewInt(10), MaxPercentRecv: sdkmath.NewInt(10), DurationHours: 24},
+			Flow:  &ratelimittypes.Flow{Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt(), ChannelValue: sdkmath.ZeroInt()},
+		},
+	}
+	cdc := gaiaApp.AppCodec()
+	pfmStoreService := runtime.NewKVStoreService(gaiaApp.GetKey(pfmtypes.StoreKey))
+	inFlightPackets := map[string]pfmtypes.InFlightPacket{
+		"channel-1/transfer/12": {RefundChannelId: "channel-0", RefundPortId: "transfer", RefundSequence: 3, RetriesRemaining: 1},
+		"channel-1/transfer/2":  {RefundChannelId: "channel-0", RefundPortId: "transfer", RefundSequence: 1, PacketData: []byte("data")},
+		"channel-0/transfer/5":  {RefundChannelId: "channel-2", RefundPortId: "transfer", RefundSequence: 7, Nonrefundable: true},
+	}
+	for key, packet := range inFlightPackets {
+		bz, err := cdc.Marshal(&packet)
+		require.NoError(t, err)
+		require.NoError(t, pfmStoreService.OpenKVStore(ctx).Set([]byte(key), bz))
+	}
+	plugin := bindings.NewQueryPlugin(cdc, gaiaApp.FeeMarketKeeper, rateLimits, pfmStoreService)
+
+	var forwarded json.RawMessage
+	querier := bindings.CustomQuerier(
+		plugin,
+		func(_ sdk.Context, request json.RawMessage) ([]byte, error) {
+			forwarded = request
+			return []byte(`{}`), nil
+		},
+	)
+	query := func(request string, response any) error {
+		bz, err := querier(ctx, json.RawMessage(request))
+		if err != nil {
+			return err
+		}
+		return json.Unmarshal(bz, response)
+	}
+
+	t.Run("fee market", func(t *testing.T) {
+		var res bindings.FeeMarketResponse
+		require.NoError(t, query(`{"fee_market":{}}`, &res))
+
+		params, err := gaiaApp.FeeMarketKeeper.GetParams(ctx)
+		require.NoError(t, err)
+		state, err := gaiaApp.FeeMarketKeeper.GetState(ctx)
+		require.NoError(t, err)
+		require.Equal(t, bindings.FeeMarketResponse{
+			Enabled:         params.Enabled,
+			FeeDenom:        params.FeeDenom,
+			BaseGasPrice:    state.BaseGasPrice.String(),
+			MinBaseGasPrice: params.MinBaseGasPrice.String(),
+			LearningRate:    state.LearningRate.String(),
+			GasPrices:       []bindings.DecCoin{{Denom: params.FeeDenom, Amount: state.BaseGasPrice.String()}},
+		}, res)
+	})
+
+	t.Run("rate limit", func(t *testing.T) {
+		var res bindings.RateLimitResponse
+		require.NoError(t, query(`{"rate_limit":{"denom":"uatom","channel_id":"channel-0"}}`, &res))
+		remainingSend, remainingRecv := "10", "290"
+		require.Equal(t, &bindings.RateLimitInfo{
+			MaxPercentSend: "10",
+			MaxPercentRecv: "20",
+			DurationHours:  24,
+			Inflow:         "30",
+			Outflow:        "120",
+			ChannelValue:   "1000",
+			RemainingSend:  &remainingSend,
+			RemainingRecv:  &remainingRecv,
+		}, res.RateLimit)
+
+		// quotas are not enforced on a zero channel value
+		require.NoError(t, query(`{"rate_limit":{"denom":"uatom","channel_id":"channel-1"}}`, &res))
+		require.Nil(t, res.RateLimit.RemainingSend)
+		require.Nil(t, res.RateLimit.RemainingRecv)
+
+		res = bindings.RateLimitResponse{}
+		require.NoError(t, query(`{"rate_limit":{"denom":"uosmo","channel_id":"channel-0"}}`, &res))
+		require.Nil(t, res.RateLimit)
+	})
+
+	t.Run("in-flight packets", func(t *testing.T) {
+		var res bindings.InFlightPacketsResponse
+		require.NoError(t, query(`{"in_flight_packets":{}}`, &res))
+		require.Equal(t, []bindings.InFlightPacket{
+			{ChannelID: "channel-0", PortID: "transfer", Sequence: 5, RefundChannelID: "channel-2", RefundPortID: "transfer", RefundSequence: 7, Nonrefundable: true},
+			{ChannelID: "channel-1", PortID: "transfer", Sequence: 12, RefundChannelID: "channel-0", RefundPortID: "transfer", RefundSequence: 3, RetriesRemaining: 1},
+			{ChannelID: "channel-1", PortID: "transfer", Sequence: 2, RefundChannelID: "channel-0", RefundPortID: "transfer", RefundSequence: 1, PacketData: []byte("data")},
+		}, res.Packets)
+		require.Empty(t, res.NextKey)
+
+		res = bindings.InFlightPacketsResponse{}
+		require.NoError(t, query(`{"in_flight_packets":{"channel_id":"channel-0"}}`, &res))
+		require.Len(t, res.Packets, 1)
+		require.Equal(t, uint64(5), res.Packets[0].Sequence)
+
+		res = bindings.InFlightPacketsResponse{}
+		require.NoError(t, query(`{"in_flight_packets":{"channel_id":"channel-9"}}`, &res))
+		require.Empty(t, res.Packets)
+
+		// the packets of a channel are paginated
+		var page bindings.InFlightPacketsResponse
+		require.NoError(t, query(`{"in_flight_packets":{"channel_id":"channel-1","limit":1}}`, &page))
+		require.Len(t, page.Packets, 1)
+		require.Equal(t, uint64(12), page.Packets[0].Sequence)
+		require.NotEmpty(t, page.NextKey)
+
+		next, err := json.Marshal(bindings.GaiaQuery{InFlightPackets: &bindings.InFlightPackets{ChannelID: "channel-1", Key: page.NextKey, Limit: 1}})
+		require.NoError(t, err)
+		page = bindings.InFlightPacketsResponse{}
+		require.NoError(t, query(string(next), &page))
+		require.Len(t, page.Packets, 1)
+		require.Equal(t, uint64(2), page.Packets[0].Sequence)
+		require.Empty(t, page.NextKey)
+	})
+
+	t.Run("other queries", func(t *testing.T) {
+		request := `{"full_denom":{"creator_addr":"cosmos1","subdenom":"token"}}`
+		require.NoError(t, query(request, &struct{}{}))
+		require.JSONEq(t, request, string(forwarded))
+
+		querier := bindings.CustomQuerier(plugin, nil)
+		_, err := querier(ctx, json.RawMessage(request))
+		require.ErrorIs(t, err, wasmvmtypes.UnsupportedRequest{Kind: "unknown gaia query variant"})
+	})
+}
@@ -0,0 +1,95 @@
+package bindings
+
+// GaiaQuery is the custom query of the Gaia wasm bindings, with exactly one
+// variant set. Queries without any of these variants are passed to the next
+// custom querier, i.e. the tokenfactory bindings.
+type GaiaQuery struct {
+	// FeeMarket returns the feemarket params and state.
+	FeeMarket *FeeMarket `json:"fee_market,omitempty"`
+	// RateLimit returns the rate limit of a denom on a channel and its
+	// remaining capacity.
+	RateLimit *RateLimit `json:"rate_limit,omitempty"`
+	// InFlightPackets returns the packets forwarded by PFM and not yet
+	// acknowledged or timed out.
+	InFlightPackets *InFlightPackets `json:"in_flight_packets,omitempty"`
+}
+
+// query types
+
+type FeeMarket struct{}
+
+type RateLimit struct {
+	Denom     string `json:"denom"`
+	ChannelID string `json:"channel_id"`
+}
+
+type InFlightPackets struct {
+	// ChannelID optionally limits the packets to those forwarded on this
+	// channel.
+	ChannelID string `json:"channel_id,omitempty"`
+	// Key is the next_key of the previous page, to continue from.
+	Key []byte `json:"key,omitempty"`
+	// Limit is the maximum number of packets returned, MaxInFlightPacketsLimit
+	// if unset or higher.
+	Limit uint64 `json:"limit,omitempty"`
+}
+
+// query responses
+
+type FeeMarketResponse struct {
+	Enabled         bool   `json:"enabled"`
+	FeeDenom        string `json:"fee_denom"`
+	BaseGasPrice    string `json:"base_gas_price"`
+	MinBaseGasPrice string `json:"min_base_gas_price"`
+	LearningRate    string `json:"learning_rate"`
+	// GasPrices are the current gas prices in each accepted fee denom.
+	GasPrices []DecCoin `json:"gas_prices"`
+}
+
+type DecCoin struct {
+	Denom  string `json:"denom"`
+	Amount string `json:"amount"`
+}
+
+type RateLimitResponse struct {
+	// RateLimit is nil if the denom is not rate limited on the channel.
+	RateLimit *RateLimitInfo `json:"rate_limit"`
+}
+
+type RateLimitInfo struct {
+	MaxPercentSend string `json:"max_percent_send"`
+	MaxPercentRecv string `json:"max_percent_recv"`
+	DurationHours  uint64 `json:"duration_hours"`
+	Inflow         string `json:"inflow"`
+	Outflow        string `json:"outflow"`
+	ChannelValue   string `json:"channel_value"`
+	// RemainingSend and RemainingRecv are the amounts that may still be sent
+	// and received in the current window. They are nil if the channel value
+	// is zero, in which case the quota is not enforced.
+	RemainingSend *string `json:"remaining_send"`
+	RemainingRecv *string `json:"remaining_recv"`
+}
+
+type InFlightPacketsResponse struct {
+	Packets []InFlightPacket `json:"packets"`
+	// NextKey is the key of the next page, empty on the last page.
+	NextKey []byte `json:"next_key,omitempty"`
+}
+
+type InFlightPacket struct {
+	// ChannelID, PortID and Sequence identify the forwarded packet.
+	ChannelID string `json:"channel_id"`
+	PortID    string `json:"port_id"`
+	Sequence  uint64 `json:"sequence"`
+	// RefundChannelID, RefundPortID and RefundSequence identify the received
+	// packet, which is refunded if the forwarded one fails.
+	RefundChannelID       string `json:"refund_channel_id"`
+	RefundPortID          string `json:"refund_port_id"`
+	RefundSequence        uint64 `json:"refund_sequence"`
+	OriginalSenderAddress string `json:"original_sender_address"`
+	PacketData            []byte `json:"packet_data"`
+	RetriesRemaining      int32  `json:"retries_remaining"`
+	// Timeout is the relative timeout of the forwarded packet in nanoseconds.
+	Timeout       uint64 `json:"timeout"`
+	Nonrefundable bool   `json:"nonrefundable"`
+}
@@ -0,0 +1,30 @@
+package bindings
+
+import (
+	corestore "cosmossdk.io/core/store"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+
+	wasmkeeper "github.com/CosmWasm/wasmd/x/wasm/keeper"
+)
+
+// RegisterCustomPlugins returns the wasm keeper options registering the Gaia
+// custom query plugin. The custom queries it does not handle are passed to
+// next, as a keeper has a single custom querier.
+func RegisterCustomPlugins(
+	cdc codec.BinaryCodec,
+	feeMarketKeeper FeeMarketKeeper,
+	rateLimitKeeper RateLimitKeeper,
+	pfmStoreService corestore.KVStoreService,
+	next wasmkeeper.CustomQuerier,
+) []wasmkeeper.Option {
+	wasmQueryPlugin := NewQueryPlugin(cdc, feeMarketKeeper, rateLimitKeeper, pfmStoreService)
+
+	queryPluginOpt := wasmkeeper.WithQueryPlugins(&wasmkeeper.QueryPlugins{
+		Custom: CustomQuerier(wasmQueryPlugin, next),
+	})
+
+	return []wasmkeeper.Option{
+		queryPluginOpt,
+	}
+}
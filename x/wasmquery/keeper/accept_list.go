@@ -0,0 +1,149 @@
+package keeper
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+
+	"github.com/cosmos/gogoproto/proto"
+	protov2 "google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+
+	queryv1 "cosmossdk.io/api/cosmos/query/v1"
+	errorsmod "cosmossdk.io/errors"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"github.com/cosmos/gaia/v29/x/wasmquery/types"
+)
+
+// legacyGrpcAcceptList are the gRPC queries not marked module_query_safe that
+// contracts could call before the accept list became a param, and may still
+// be accepted so that the existing contracts keep working.
+var legacyGrpcAcceptList = map[string]struct{}{
+	"/cosmos.gov.v1.Query/Proposal": {},
+}
+
+// ResponseType returns the full name of the response message of the gRPC
+// query path, resolved through the interface registry. It fails if the path
+// is not a query method known to the registry or routed by the chain, or if
+// the method is not marked with the cosmos.query.v1.module_query_safe option,
+// as the queries of contracts must be deterministic and gas metered.
+func (k Keeper) ResponseType(path string) (string, error) {
+	service, method, err := types.ParseQueryPath(path)
+	if err != nil {
+		return "", err
+	}
+	desc, err := k.cdc.InterfaceRegistry().FindDescriptorByName(protoreflect.FullName(service))
+	if err != nil {
+		return "", errorsmod.Wrapf(types.ErrUnknownQuery, "%s: unknown service %s", path, service)
+	}
+	serviceDesc, ok := desc.(protoreflect.ServiceDescriptor)
+	if !ok {
+		return "", errorsmod.Wrapf(types.ErrUnknownQuery, "%s: %s is not a service", path, service)
+	}
+	methodDesc := serviceDesc.Methods().ByName(protoreflect.Name(method))
+	if methodDesc == nil {
+		return "", errorsmod.Wrapf(types.ErrUnknownQuery, "%s: no method %s in %s", path, method, service)
+	}
+	if _, legacy := legacyGrpcAcceptList[path]; !legacy && !isModuleQuerySafe(methodDesc) {
+		return "", errorsmod.Wrapf(types.ErrUnsafeQuery, "%s: not marked module_query_safe", path)
+	}
+	if k.queryRouter.Route(path) == nil {
+		return "", errorsmod.Wrapf(types.ErrUnknownQuery, "%s: not routed", path)
+	}
+	return string(methodDesc.Output().FullName()), nil
+}
+
+// isModuleQuerySafe returns true if the method has the
+// cosmos.query.v1.module_query_safe option set.
+func isModuleQuerySafe(method protoreflect.MethodDescriptor) bool {
+	options := method.Options()
+	if options == nil {
+		return false
+	}
+	safe, ok := protov2.GetExtension(options, queryv1.E_ModuleQuerySafe).(bool)
+	return ok && safe
+}
+
+// newResponse returns an empty response message of the gRPC query path.
+func (k Keeper) newResponse(path string) (proto.Message, error) {
+	name, err := k.ResponseType(path)
+	if err != nil {
+		return nil, err
+	}
+	typ := proto.MessageType(name)
+	if typ == nil || typ.Kind() != reflect.Ptr {
+		return nil, errorsmod.Wrapf(types.ErrUnknownQuery, "%s: no Go type for %s", path, name)
+	}
+	msg, ok := reflect.New(typ.Elem()).Interface().(proto.Message)
+	if !ok {
+		return nil, errorsmod.Wrapf(types.ErrUnknownQuery, "%s: %s is not a message", path, name)
+	}
+	return msg, nil
+}
+
+// ValidateAcceptList checks that every path of the gRPC accept list of params
+// is resolved to a response type.
+func (k Keeper) ValidateAcceptList(params types.Params) error {
+	for _, path := range params.GrpcAcceptList {
+		if _, err := k.newResponse(path); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// IsAccepted returns true if contracts may call the gRPC query path.
+func (k Keeper) IsAccepted(ctx context.Context, path string) (bool, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return false, err
+	}
+	for _, accepted := range params.GrpcAcceptList {
+		if accepted == path {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// GrpcQuerier returns the wasm Grpc query plugin, running the queries of the
+// gRPC accept list param. It behaves as the wasmd AcceptListGrpcQuerier,
+// with the accept list read from the state on every query.
+func (k Keeper) GrpcQuerier() func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
+	return func(ctx sdk.Context, request *wasmvmtypes.GrpcQuery) (proto.Message, error) {
+		accepted, err := k.IsAccepted(ctx, request.Path)
+		if err != nil {
+			return nil, err
+		}
+		if !accepted {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("'%s' path is not allowed from the contract", request.Path)}
+		}
+
+		handler := k.queryRouter.Route(request.Path)
+		if handler == nil {
+			return nil, wasmvmtypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query '%s'", request.Path)}
+		}
+		response, err := k.newResponse(request.Path)
+		if err != nil {
+			return nil, err
+		}
+
+		res, err := handler(ctx, &abci.RequestQuery{
+			Data: request.Data,
+			Path: request.Path,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if err := k.cdc.Unmarshal(res.Value, response); err != nil {
+			return nil, err
+		}
+		return response, nil
+	}
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	"context"
+
+	"github.com/cosmos/gaia/v29/x/wasmquery/types"
+)
+
+// Querier is used as Keeper will have duplicate methods if used directly, and gRPC names take precedence over keeper
+type Querier struct {
+	*Keeper
+}
+
+var _ types.QueryServer = Querier{}
+
+func NewQuerier(keeper *Keeper) Querier {
+	return Querier{Keeper: keeper}
+}
+
+// Params queries the wasmquery parameters
+func (k Querier) Params(ctx context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryParamsResponse{Params: params}, nil
+}
+
+// AcceptedQueries queries the gRPC query paths contracts may call
+func (k Querier) AcceptedQueries(ctx context.Context, _ *types.QueryAcceptedQueriesRequest) (*types.QueryAcceptedQueriesResponse, error) {
+	params, err := k.GetParams(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	accepted := make([]types.AcceptedQuery, len(params.GrpcAcceptList))
+	for i, path := range params.GrpcAcceptList {
+		// an unresolved path has no response type
+		responseType, _ := k.ResponseType(path)
+		accepted[i] = types.AcceptedQuery{Path: path, ResponseType: responseType}
+	}
+	return &types.QueryAcceptedQueriesResponse{AcceptedQueries: accepted}, nil
+}
@@ -0,0 +1,52 @@
+package keeper
+
+import (
+	"context"
+
+	storetypes "cosmossdk.io/core/store"
+	"cosmossdk.io/log"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/cosmos/gaia/v29/x/wasmquery/types"
+)
+
+// Keeper of the x/wasmquery store
+type Keeper struct {
+	storeService storetypes.KVStoreService
+	cdc          codec.Codec
+	queryRouter  types.GRPCQueryRouter
+	authority    string
+}
+
+// NewKeeper creates a new wasmquery Keeper instance
+func NewKeeper(
+	cdc codec.Codec,
+	storeService storetypes.KVStoreService,
+	queryRouter types.GRPCQueryRouter,
+	authority string,
+) *Keeper {
+	// ensure that authority is a valid AccAddress
+	if _, err := sdk.AccAddressFromBech32(authority); err != nil {
+		panic("authority is not a valid acc address")
+	}
+
+	return &Keeper{
+		storeService: storeService,
+		cdc:          cdc,
+		queryRouter:  queryRouter,
+		authority:    authority,
+	}
+}
+
+// Logger returns a module-specific logger.
+func (k Keeper) Logger(ctx context.Context) log.Logger {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	return sdkCtx.Logger().With("module", "x/"+types.ModuleName)
+}
+
+// GetAuthority returns the x/wasmquery module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
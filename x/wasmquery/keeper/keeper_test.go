@@ -0,0 +1,118 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+
+	wasmvmtypes "github.com/CosmWasm/wasmvm/v2/types"
+
+	"github.com/cosmos/gaia/v29/app/helpers"
+	"github.com/cosmos/gaia/v29/x/wasmquery/keeper"
+	"github.com/cosmos/gaia/v29/x/wasmquery/types"
+)
+
+const denomMetadataPath = "/cosmos.bank.v1beta1.Query/DenomMetadata"
+
+func TestResponseType(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	k := gaiaApp.WasmQueryKeeper
+
+	for _, tc := range []struct {
+		path    string
+		expType string
+		expErr  string
+	}{
+		{path: denomMetadataPath, expType: "cosmos.bank.v1beta1.QueryDenomMetadataResponse"},
+		{path: "/gaia.liquid.v1beta1.Query/Params", expType: "gaia.liquid.v1beta1.QueryParamsResponse"},
+		{path: "cosmos.bank.v1beta1.Query/Balance", expErr: `invalid gRPC query path "cosmos.bank.v1beta1.Query/Balance", expected /<service>/<method>`},
+		{path: "/cosmos.bank.v1beta1.Query/Unknown", expErr: "/cosmos.bank.v1beta1.Query/Unknown: no method Unknown in cosmos.bank.v1beta1.Query: unknown gRPC query"},
+		{path: "/cosmos.bank.v1beta1.Unknown/Balance", expErr: "/cosmos.bank.v1beta1.Unknown/Balance: unknown service cosmos.bank.v1beta1.Unknown: unknown gRPC query"},
+		{path: "/gaia.metaprotocols.Query/Extensions", expErr: "/gaia.metaprotocols.Query/Extensions: not marked module_query_safe: gRPC query not module query safe"},
+		{path: "/cosmos.tx.v1beta1.Service/Simulate", expErr: "/cosmos.tx.v1beta1.Service/Simulate: not marked module_query_safe: gRPC query not module query safe"},
+		{path: "/cosmos.bank.v1beta1.MsgSend/Balance", expErr: "/cosmos.bank.v1beta1.MsgSend/Balance: cosmos.bank.v1beta1.MsgSend is not a service: unknown gRPC query"},
+	} {
+		t.Run(tc.path, func(t *testing.T) {
+			responseType, err := k.ResponseType(tc.path)
+			if tc.expErr != "" {
+				require.EqualError(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expType, responseType)
+		})
+	}
+}
+
+func TestGrpcAcceptList(t *testing.T) {
+	gaiaApp := helpers.Setup(t)
+	ctx := gaiaApp.NewUncachedContext(false, tmproto.Header{Height: 10})
+	k := gaiaApp.WasmQueryKeeper
+	msgServer := keeper.NewMsgServerImpl(k)
+	querier := keeper.NewQuerier(k)
+	authority := k.GetAuthority()
+
+	metadata := banktypes.Metadata{
+		Base:    "utoken",
+		Display: "token",
+		Name:    "Token",
+		Symbol:  "TOKEN",
+		DenomUnits: []*banktypes.DenomUnit{
+			{Denom: "utoken", Exponent: 0},
+			{Denom: "token", Exponent: 6},
+		},
+	}
+	gaiaApp.BankKeeper.SetDenomMetaData(ctx, metadata)
+	request := &wasmvmtypes.GrpcQuery{
+		Path: denomMetadataPath,
+		Data: gaiaApp.AppCodec().MustMarshal(&banktypes.QueryDenomMetadataRequest{Denom: "utoken"}),
+	}
+
+	// denom metadata queries are not accepted by default
+	grpcQuerier := k.GrpcQuerier()
+	_, err := grpcQuerier(ctx, request)
+	require.ErrorIs(t, err, wasmvmtypes.UnsupportedRequest{Kind: "'/cosmos.bank.v1beta1.Query/DenomMetadata' path is not allowed from the contract"})
+
+	res, err := querier.AcceptedQueries(ctx, &types.QueryAcceptedQueriesRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.AcceptedQuery{
+		{Path: "/cosmos.staking.v1beta1.Query/Validator", ResponseType: "cosmos.staking.v1beta1.QueryValidatorResponse"},
+		{Path: "/cosmos.gov.v1.Query/Proposal", ResponseType: "cosmos.gov.v1.QueryProposalResponse"},
+	}, res.AcceptedQueries)
+
+	params := types.NewParams(append(types.DefaultGrpcAcceptList, denomMetadataPath)...)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: sdk.AccAddress("other").String(), Params: params})
+	require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams(denomMetadataPath, denomMetadataPath),
+	})
+	require.EqualError(t, err, "duplicate gRPC query path: /cosmos.bank.v1beta1.Query/DenomMetadata")
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams("/cosmos.bank.v1beta1.Query/Unknown"),
+	})
+	require.ErrorIs(t, err, types.ErrUnknownQuery)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{
+		Authority: authority,
+		Params:    types.NewParams("/gaia.metaprotocols.Query/Extensions"),
+	})
+	require.ErrorIs(t, err, types.ErrUnsafeQuery)
+	_, err = msgServer.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authority, Params: params})
+	require.NoError(t, err)
+
+	response, err := grpcQuerier(ctx, request)
+	require.NoError(t, err)
+	require.Equal(t, &banktypes.QueryDenomMetadataResponse{Metadata: metadata}, response)
+
+	res, err = querier.AcceptedQueries(ctx, &types.QueryAcceptedQueriesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.AcceptedQueries, 3)
+	require.Equal(t, types.AcceptedQuery{Path: denomMetadataPath, ResponseType: "cosmos.bank.v1beta1.QueryDenomMetadataResponse"}, res.AcceptedQueries[2])
+}
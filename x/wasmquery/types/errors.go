@@ -0,0 +1,9 @@
+package types
+
+import "cosmossdk.io/errors"
+
+// x/wasmquery module sentinel errors
+var (
+	ErrUnknownQuery = errors.Register(ModuleName, 2, "unknown gRPC query")
+	ErrUnsafeQuery  = errors.Register(ModuleName, 3, "gRPC query not module query safe")
+)
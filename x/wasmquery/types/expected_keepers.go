@@ -0,0 +1,10 @@
+package types
+
+import (
+	"github.com/cosmos/cosmos-sdk/baseapp"
+)
+
+// GRPCQueryRouter routes the gRPC queries of contracts.
+type GRPCQueryRouter interface {
+	Route(path string) baseapp.GRPCQueryHandler
+}
@@ -0,0 +1,15 @@
+package types
+
+const (
+	// ModuleName is the name of the wasmquery module
+	ModuleName = "wasmquery"
+
+	// StoreKey is the string store representation. It differs from the module
+	// name, which shares a prefix with the wasm store key.
+	StoreKey = "gaiawasmquery"
+
+	// RouterKey is the msg router key for the wasmquery module
+	RouterKey = ModuleName
+)
+
+var ParamsKey = []byte{0x01} // key for the parameters of module x/wasmquery
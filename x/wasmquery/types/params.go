@@ -0,0 +1,50 @@
+package types
+
+import (
+	"fmt"
+	"strings"
+)
+
+// DefaultGrpcAcceptList are the gRPC queries contracts may call by default:
+// validator info and governance proposal state.
+var DefaultGrpcAcceptList = []string{
+	"/cosmos.staking.v1beta1.Query/Validator",
+	"/cosmos.gov.v1.Query/Proposal",
+}
+
+// NewParams creates a new Params instance
+func NewParams(grpcAcceptList ...string) Params {
+	return Params{
+		GrpcAcceptList: grpcAcceptList,
+	}
+}
+
+// DefaultParams returns a default set of parameters.
+func DefaultParams() Params {
+	return NewParams(DefaultGrpcAcceptList...)
+}
+
+// validate a set of params
+func (p Params) Validate() error {
+	paths := make(map[string]struct{}, len(p.GrpcAcceptList))
+	for _, path := range p.GrpcAcceptList {
+		if _, _, err := ParseQueryPath(path); err != nil {
+			return err
+		}
+		if _, ok := paths[path]; ok {
+			return fmt.Errorf("duplicate gRPC query path: %s", path)
+		}
+		paths[path] = struct{}{}
+	}
+	return nil
+}
+
+// ParseQueryPath returns the full name of the service and the name of the
+// method of a gRPC query path "/<service>/<method>".
+func ParseQueryPath(path string) (service, method string, err error) {
+	service, method, ok := strings.Cut(strings.TrimPrefix(path, "/"), "/")
+	if !strings.HasPrefix(path, "/") || !ok || service == "" || method == "" || strings.Contains(method, "/") {
+		return "", "", fmt.Errorf("invalid gRPC query path %q, expected /<service>/<method>", path)
+	}
+	return service, method, nil
+}